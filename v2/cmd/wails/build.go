@@ -85,6 +85,7 @@ func buildApplication(f *flags.Build) error {
 		Compress:          f.Upx,
 		CompressFlags:     f.UpxFlags,
 		UserTags:          compiledTags,
+		FrontendTags:      f.GetFrontendTags(),
 		WebView2Strategy:  f.GetWebView2Strategy(),
 		TrimPath:          f.TrimPath,
 		RaceDetector:      f.RaceDetector,
@@ -115,6 +116,7 @@ func buildApplication(f *flags.Build) error {
 		{"Clean Bin Dir", bool2Str(f.Clean)},
 		{"LDFlags", f.LdFlags},
 		{"Tags", "[" + strings.Join(compiledTags, ",") + "]"},
+		{"Frontend Tags", "[" + strings.Join(f.GetFrontendTags(), ",") + "]"},
 		{"Race Detector", bool2Str(f.RaceDetector)},
 	}...)
 	if len(buildOptions.OutputFile) > 0 && f.GetTargets().Length() == 1 {
@@ -6,6 +6,7 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/wailsapp/wails/v2/cmd/wails/flags"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/dev"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
 	"github.com/wailsapp/wails/v2/internal/colour"
 	"github.com/wailsapp/wails/v2/pkg/clilogger"
 )
@@ -22,7 +23,20 @@ func devApplication(f *flags.Dev) error {
 	logger := clilogger.New(os.Stdout)
 	logger.Mute(quiet)
 
-	if quiet {
+	// With -logjson the messages are written as JSON lines, so the banner and the output of pterm are left out
+	jsonEnabled := false
+	logJSON := func() {
+		jsonEnabled = true
+		pterm.DisableOutput()
+		pterm.DisableColor()
+		colour.ColourEnabled = false
+		logutils.EnableJSON(os.Stdout)
+		logger.Writer = logutils.JSONWriter(logutils.LevelInfo, os.Stdout)
+	}
+
+	if f.LogJSON {
+		logJSON()
+	} else if quiet {
 		pterm.DisableOutput()
 	} else {
 		app.PrintBanner()
@@ -32,6 +46,13 @@ func devApplication(f *flags.Dev) error {
 	if err != nil {
 		return err
 	}
+	// The flag may have been set in the dev flags of wails.json
+	if f.LogJSON && !jsonEnabled {
+		logJSON()
+	}
 
+	if f.FrontendOnly {
+		return dev.BuildFrontend(f, logger)
+	}
 	return dev.Application(f, logger)
 }
@@ -47,6 +47,7 @@ type Build struct {
 	// Internal state
 	compilerPath  string
 	userTags      []string
+	frontendTags  []string
 	wv2rtstrategy string // WebView2 runtime strategy
 	defaultArch   string // Default architecture
 }
@@ -102,6 +103,10 @@ func (b *Build) GetTags() []string {
 	return b.userTags
 }
 
+func (b *Build) GetFrontendTags() []string {
+	return b.frontendTags
+}
+
 func (b *Build) Process() error {
 	// Lookup compiler path
 	var err error
@@ -115,6 +120,10 @@ func (b *Build) Process() error {
 	if err != nil {
 		return err
 	}
+	b.frontendTags, err = buildtags.Parse(b.FrontendTags)
+	if err != nil {
+		return err
+	}
 
 	// WebView2 installer strategy (download by default)
 	b.WebView2 = strings.ToLower(b.WebView2)
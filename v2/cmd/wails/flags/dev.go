@@ -1,12 +1,16 @@
 package flags
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/samber/lo"
 	"github.com/wailsapp/wails/v2/internal/project"
@@ -18,7 +22,14 @@ type Dev struct {
 
 	AssetDir             string `flag:"assetdir" description:"Serve assets from the given directory instead of using the provided asset FS"`
 	Extensions           string `flag:"e" description:"Extensions to trigger rebuilds (comma separated) eg go"`
+	ReloadExtensions     string `flag:"reloadextensions" description:"Extensions to trigger reloads without a rebuild (comma separated) eg tmpl"`
 	ReloadDirs           string `flag:"reloaddirs" description:"Additional directories to trigger reloads (comma separated)"`
+	ReloadDirsMaxDepth   int    `flag:"reloaddirsmaxdepth" description:"The maximum depth of subdirectories of reloaddirs and watchdirs to watch (0 = unlimited)"`
+	WatchActions         string `flag:"watchactions" description:"The action per file extension, eg sql:rebuild,html:fullreload (comma separated). Actions: rebuild, reload, fullreload and ignore"`
+	WatchDirs            string `flag:"watchdirs" description:"Additional directories, also outside of the project, whose changes trigger rebuilds (comma separated)"`
+	WatchAll             bool   `flag:"watchall" description:"Also watch the directories matching .gitignore, dot directories except .git and the build directory"`
+	PollWatcher          bool   `flag:"pollwatcher" description:"Poll the watched directories for changes, eg on network filesystems where changes aren't reported"`
+	PollInterval         int    `flag:"pollinterval" description:"The interval in milliseconds to poll the watched directories with -pollwatcher or once the limit of watches has been reached"`
 	Browser              bool   `flag:"browser" description:"Open the application in a browser"`
 	NoReload             bool   `flag:"noreload" description:"Disable reload on asset change"`
 	NoColour             bool   `flag:"nocolor" description:"Disable colour in output"`
@@ -27,23 +38,59 @@ type Dev struct {
 	LogLevel             string `flag:"loglevel" description:"LogLevel to use - Trace, Debug, Info, Warning, Error)"`
 	ForceBuild           bool   `flag:"f" description:"Force build of application"`
 	Debounce             int    `flag:"debounce" description:"The amount of time to wait to trigger a reload on change"`
+	GoDebounce           int    `flag:"godebounce" description:"The amount of time to wait to trigger a rebuild on a change to a Go file (default: -debounce)"`
 	DevServer            string `flag:"devserver" description:"The address of the wails dev server"`
+	DevScheme            string `flag:"devscheme" description:"The scheme of the dev server URL: http or https, eg behind a TLS terminating proxy"`
+	DevHost              string `flag:"devhost" description:"The host or IP address the wails dev server binds to, overrides the host of -devserver"`
+	DevPort              int    `flag:"devport" description:"The port the wails dev server binds to, overrides the port of -devserver"`
+	Host                 string `flag:"host" description:"The host or IP address the wails dev server binds to, eg 0.0.0.0 to reach it from another machine"`
 	AppArgs              string `flag:"appargs" description:"arguments to pass to the underlying app (quoted and space separated)"`
 	Save                 bool   `flag:"save" description:"Save the given flags as defaults"`
 	FrontendDevServerURL string `flag:"frontenddevserverurl" description:"The url of the external frontend dev server to use"`
 	DlvFlag              string `flag:"dlvflag" description:"Debug flags pass to dlv"`
 	ViteServerTimeout    int    `flag:"viteservertimeout" description:"The timeout in seconds for Vite server detection (default: 10)"`
+	DevShutdownTimeout   int    `flag:"devshutdowntimeout" description:"The time in seconds the frontend DevWatcher gets to exit before it's killed"`
+	RestartFrontend      bool   `name:"restartfrontendonrebuild" description:"Restart the frontend DevWatcher commands with every rebuild of the application"`
+	Instances            int    `flag:"instances" description:"The number of app instances to launch, eg to test single instance handling"`
+	DumpAssets           bool   `flag:"dumpassets" description:"Log the path and size of every asset when the app starts"`
+	DryRun               bool   `flag:"dryrun" description:"Validate the configuration and print the plan without building or running the application"`
+	FrontendOnly         bool   `name:"frontend-only" description:"Build the frontend assets and exit without building or running the application, eg to debug the asset pipeline"`
+	Offline              bool   `flag:"offline" description:"Only use the Go module cache and don't access the network, a failing go mod tidy is reported as a warning"`
+	SafeMode             bool   `flag:"safemode" description:"Start the application without user scripts, custom schemes, custom asset handlers and other customisations"`
+	EnvFile              string `flag:"envfile" description:"The file with environment variables for the application (default: .env in the project directory, if it exists)"`
+	LogJSON              bool   `flag:"logjson" description:"Write the messages of dev as JSON lines with the level, message and timestamp, eg for CI and IDE integrations"`
 
 	// Internal state
 	devServerURL  *url.URL
+	controlURL    *url.URL
 	projectConfig *project.Project
+	watchDirs     []string
+	watchActions  map[string]string
+	envFile       string
 }
 
+// The actions of -watchactions
+const (
+	// WatchActionRebuild rebuilds and restarts the application
+	WatchActionRebuild = "rebuild"
+	// WatchActionReload reloads the changed assets in place where possible, eg stylesheets
+	WatchActionReload = "reload"
+	// WatchActionFullReload reloads the frontend
+	WatchActionFullReload = "fullreload"
+	// WatchActionIgnore ignores the change
+	WatchActionIgnore = "ignore"
+)
+
 func (*Dev) Default() *Dev {
 	result := &Dev{
 		Extensions: "go",
 		Debounce:   100,
 		LogLevel:   "Info",
+		Instances:  1,
+
+		DevShutdownTimeout: 5,
+		PollInterval:       1000,
+		DevScheme:          "http",
 	}
 	result.BuildCommon = result.BuildCommon.Default()
 	return result
@@ -56,18 +103,188 @@ func (d *Dev) Process() error {
 		return err
 	}
 
-	if _, _, err := net.SplitHostPort(d.DevServer); err != nil {
-		return fmt.Errorf("DevServer is not of the form 'host:port', please check your wails.json")
+	err = d.processDevServer()
+	if err != nil {
+		return err
+	}
+
+	if d.FrontendOnly && d.SkipFrontend {
+		return fmt.Errorf("frontend-only can't be used with -s, as it only builds the frontend")
+	}
+	if d.FrontendOnly && d.DryRun {
+		return fmt.Errorf("frontend-only can't be used with -dryrun")
+	}
+
+	if d.GoDebounce < 0 {
+		return fmt.Errorf("godebounce can't be negative")
+	}
+	if d.GoDebounce == 0 {
+		d.GoDebounce = d.Debounce
+	}
+
+	if d.ReloadDirsMaxDepth < 0 {
+		return fmt.Errorf("reloaddirsmaxdepth can't be negative")
 	}
 
-	d.devServerURL, err = url.Parse("http://" + d.DevServer)
+	for _, dir := range strings.Split(d.WatchDirs, ",") {
+		if dir == "" {
+			continue
+		}
+		thePath, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		if info, err := os.Stat(thePath); err != nil || !info.IsDir() {
+			return fmt.Errorf("watchdir '%s' does not exist or is not a directory", dir)
+		}
+		d.watchDirs = append(d.watchDirs, thePath)
+	}
+
+	d.watchActions, err = parseWatchActions(d.Extensions, d.ReloadExtensions, d.WatchActions)
 	if err != nil {
 		return err
 	}
 
+	if d.EnvFile != "" {
+		d.envFile, err = filepath.Abs(d.EnvFile)
+		if err != nil {
+			return err
+		}
+		if info, err := os.Stat(d.envFile); err != nil || info.IsDir() {
+			return fmt.Errorf("envfile '%s' does not exist or is not a file", d.EnvFile)
+		}
+	} else if info, err := os.Stat(".env"); err == nil && !info.IsDir() {
+		d.envFile, err = filepath.Abs(".env")
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.PollInterval <= 0 {
+		return fmt.Errorf("pollinterval must be positive")
+	}
+
+	if d.DevShutdownTimeout < 0 {
+		return fmt.Errorf("devshutdowntimeout can't be negative")
+	}
+
+	if d.Instances < 1 {
+		return fmt.Errorf("instances must be at least 1")
+	}
+	if d.Instances > 1 && d.DlvFlag != "" {
+		return fmt.Errorf("instances can't be used together with dlvflag")
+	}
+
 	return nil
 }
 
+// processDevServer composes the address of the wails dev server of -devserver, -devhost (or -host) and -devport,
+// and its URL of the address and -devscheme. The control URL is derived from the address only
+func (d *Dev) processDevServer() error {
+	host, port, err := net.SplitHostPort(d.DevServer)
+	if err != nil {
+		return fmt.Errorf("DevServer is not of the form 'host:port', please check your wails.json")
+	}
+
+	if d.Host != "" && d.DevHost != "" && d.Host != d.DevHost {
+		return fmt.Errorf("host '%s' and devhost '%s' can't both be given", d.Host, d.DevHost)
+	}
+	if devHost, _ := lo.Coalesce(d.DevHost, d.Host); devHost != "" {
+		if !isValidHost(devHost) {
+			return fmt.Errorf("host '%s' is not a valid hostname or IP address", devHost)
+		}
+		host = devHost
+	}
+
+	if d.DevPort < 0 || d.DevPort > 65535 {
+		return fmt.Errorf("devport %d is not a valid port", d.DevPort)
+	}
+	if d.DevPort != 0 {
+		port = strconv.Itoa(d.DevPort)
+	}
+
+	d.DevScheme = strings.ToLower(d.DevScheme)
+	if d.DevScheme == "" {
+		d.DevScheme = "http"
+	}
+	if d.DevScheme != "http" && d.DevScheme != "https" {
+		return fmt.Errorf("devscheme '%s' is not supported, please use http or https", d.DevScheme)
+	}
+
+	d.DevServer = net.JoinHostPort(host, port)
+	d.devServerURL, err = url.Parse(d.DevScheme + "://" + d.DevServer)
+	if err != nil {
+		return err
+	}
+
+	// The dev server of the application always serves plain HTTP, -devscheme is for a TLS terminating proxy in front
+	// of it. Connecting to an unspecified address fails on Windows, so the loopback address is used instead
+	controlHost := host
+	if ip := net.ParseIP(host); host == "" || ip.IsUnspecified() {
+		controlHost = "127.0.0.1"
+		if ip != nil && ip.To4() == nil {
+			controlHost = "::1"
+		}
+	}
+	d.controlURL, err = url.Parse("http://" + net.JoinHostPort(controlHost, port))
+	return err
+}
+
+// parseWatchActions returns the action per file extension. The extensions trigger rebuilds and the reload extensions
+// full reloads, an extension in both lists triggers rebuilds. The actions override both of them
+func parseWatchActions(extensions string, reloadExtensions string, actions string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, extension := range strings.Split(reloadExtensions, ",") {
+		if extension = strings.TrimPrefix(strings.TrimSpace(extension), "."); extension != "" {
+			result[extension] = WatchActionFullReload
+		}
+	}
+	for _, extension := range strings.Split(extensions, ",") {
+		if extension = strings.TrimPrefix(strings.TrimSpace(extension), "."); extension != "" {
+			result[extension] = WatchActionRebuild
+		}
+	}
+	for _, entry := range strings.Split(actions, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		extension, action, found := strings.Cut(entry, ":")
+		extension = strings.TrimPrefix(strings.TrimSpace(extension), ".")
+		action = strings.TrimSpace(action)
+		if !found || extension == "" {
+			return nil, fmt.Errorf("watchactions entry '%s' is not of the form 'extension:action'", entry)
+		}
+		switch action {
+		case WatchActionRebuild, WatchActionReload, WatchActionFullReload, WatchActionIgnore:
+			result[extension] = action
+		default:
+			return nil, fmt.Errorf("watchactions entry '%s' has got the unknown action '%s'", entry, action)
+		}
+	}
+	return result, nil
+}
+
+// isValidHost returns true if host is an IP address or a hostname
+func isValidHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	if len(host) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (d *Dev) loadAndMergeProjectConfig() error {
 	var err error
 	cwd, err := os.Getwd()
@@ -79,6 +296,11 @@ func (d *Dev) loadAndMergeProjectConfig() error {
 		return err
 	}
 
+	err = d.applyProjectDevFlags()
+	if err != nil {
+		return err
+	}
+
 	d.AssetDir, _ = lo.Coalesce(d.AssetDir, d.projectConfig.AssetDirectory)
 	d.projectConfig.AssetDirectory = filepath.ToSlash(d.AssetDir)
 	if d.AssetDir != "" {
@@ -90,6 +312,8 @@ func (d *Dev) loadAndMergeProjectConfig() error {
 
 	d.ReloadDirs, _ = lo.Coalesce(d.ReloadDirs, d.projectConfig.ReloadDirectories)
 	d.projectConfig.ReloadDirectories = filepath.ToSlash(d.ReloadDirs)
+	d.WatchDirs, _ = lo.Coalesce(d.WatchDirs, d.projectConfig.WatchDirectories)
+	d.projectConfig.WatchDirectories = filepath.ToSlash(d.WatchDirs)
 	d.DevServer, _ = lo.Coalesce(d.DevServer, d.projectConfig.DevServer)
 	d.projectConfig.DevServer = d.DevServer
 	d.FrontendDevServerURL, _ = lo.Coalesce(d.FrontendDevServerURL, d.projectConfig.FrontendDevServerURL)
@@ -124,6 +348,54 @@ func (d *Dev) loadAndMergeProjectConfig() error {
 	return nil
 }
 
+// applyProjectDevFlags sets the flags of the `dev` section in wails.json. The command line wins, like with the
+// other project settings a flag counts as given if it doesn't have its default value
+func (d *Dev) applyProjectDevFlags() error {
+	if len(d.projectConfig.DevFlags) == 0 {
+		return nil
+	}
+
+	fields := devFlagFields(reflect.ValueOf(d).Elem())
+	defaults := devFlagFields(reflect.ValueOf(d.Default()).Elem())
+	for name, value := range d.projectConfig.DevFlags {
+		field, exists := fields[name]
+		if !exists {
+			return fmt.Errorf("wails.json: unknown dev flag '%s'", name)
+		}
+		if !reflect.DeepEqual(field.Interface(), defaults[name].Interface()) {
+			continue
+		}
+		if err := json.Unmarshal(value, field.Addr().Interface()); err != nil {
+			return fmt.Errorf("wails.json: invalid value for dev flag '%s': %w", name, err)
+		}
+	}
+	return nil
+}
+
+// devFlagFields returns the fields of the flags struct by their flag name, named the same way as the command line
+// parser does
+func devFlagFields(value reflect.Value) map[string]reflect.Value {
+	result := map[string]reflect.Value{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			for name, value := range devFlagFields(value.Field(i)) {
+				result[name] = value
+			}
+			continue
+		}
+		name := field.Tag.Get("name")
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		result[name] = value.Field(i)
+	}
+	return result
+}
+
 // GenerateBuildOptions creates a build.Options using the flags
 func (d *Dev) GenerateBuildOptions() *build.Options {
 	result := &build.Options{
@@ -156,3 +428,25 @@ func (d *Dev) ProjectConfig() *project.Project {
 func (d *Dev) DevServerURL() *url.URL {
 	return d.devServerURL
 }
+
+// ControlURL returns the URL wails dev sends its requests to the dev server of the application to, eg to reload
+// the frontend. Unlike DevServerURL it ignores -devscheme and uses the loopback address for an unspecified host
+func (d *Dev) ControlURL() *url.URL {
+	return d.controlURL
+}
+
+// WatchAction returns the action for a change of the file, an empty string if there's no action for its extension
+func (d *Dev) WatchAction(fileName string) string {
+	return d.watchActions[strings.TrimPrefix(filepath.Ext(fileName), ".")]
+}
+
+// WatchDirectories returns the absolute paths of the watchdirs
+func (d *Dev) WatchDirectories() []string {
+	return d.watchDirs
+}
+
+// EnvFilePath returns the absolute path of the file with the environment variables for the application,
+// an empty string if there's none
+func (d *Dev) EnvFilePath() string {
+	return d.envFile
+}
@@ -0,0 +1,93 @@
+package flags
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestDevApplyProjectDevFlags(t *testing.T) {
+	d := (*Dev)(nil).Default()
+	d.Debounce = 300
+	d.projectConfig = &project.Project{
+		DevFlags: map[string]json.RawMessage{
+			"debounce":   json.RawMessage(`200`),
+			"extensions": json.RawMessage(`"go,ts"`),
+			"noreload":   json.RawMessage(`true`),
+			"v":          json.RawMessage(`2`),
+		},
+	}
+	require.NoError(t, d.applyProjectDevFlags())
+	// Given on the command line
+	require.Equal(t, 300, d.Debounce)
+	require.Equal(t, "go,ts", d.Extensions)
+	require.True(t, d.NoReload)
+	require.Equal(t, 2, d.Verbosity)
+
+	d.projectConfig.DevFlags = map[string]json.RawMessage{"unknown": json.RawMessage(`1`)}
+	require.ErrorContains(t, d.applyProjectDevFlags(), "unknown dev flag 'unknown'")
+
+	d.projectConfig.DevFlags = map[string]json.RawMessage{"instances": json.RawMessage(`"two"`)}
+	require.ErrorContains(t, d.applyProjectDevFlags(), "invalid value for dev flag 'instances'")
+}
+
+func TestParseWatchActions(t *testing.T) {
+	actions, err := parseWatchActions("go,.sql", "tmpl, .go", "html:fullreload, .css:reload,go:rebuild,sql:ignore")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"go":   WatchActionRebuild,
+		"sql":  WatchActionIgnore,
+		"html": WatchActionFullReload,
+		"css":  WatchActionReload,
+		"tmpl": WatchActionFullReload,
+	}, actions)
+
+	d := &Dev{watchActions: actions}
+	require.Equal(t, WatchActionFullReload, d.WatchAction("frontend/index.html"))
+	require.Equal(t, "", d.WatchAction("README"))
+
+	_, err = parseWatchActions("go", "", "html")
+	require.ErrorContains(t, err, "not of the form")
+	_, err = parseWatchActions("go", "", "html:refresh")
+	require.ErrorContains(t, err, "unknown action 'refresh'")
+}
+
+func TestDevProcessDevServer(t *testing.T) {
+	tests := []struct {
+		name           string
+		dev            Dev
+		want           string
+		wantURL        string
+		wantControlURL string
+		wantErr        string
+	}{
+		{name: "devserver", dev: Dev{DevServer: "localhost:34115"}, want: "localhost:34115", wantURL: "http://localhost:34115", wantControlURL: "http://localhost:34115"},
+		{name: "host", dev: Dev{DevServer: "localhost:34115", Host: "0.0.0.0"}, want: "0.0.0.0:34115", wantURL: "http://0.0.0.0:34115", wantControlURL: "http://127.0.0.1:34115"},
+		{name: "unspecified ipv6 host", dev: Dev{DevServer: "localhost:34115", Host: "::"}, want: "[::]:34115", wantURL: "http://[::]:34115", wantControlURL: "http://[::1]:34115"},
+		{name: "empty host", dev: Dev{DevServer: ":34115"}, want: ":34115", wantURL: "http://:34115", wantControlURL: "http://127.0.0.1:34115"},
+		{name: "devhost and devport", dev: Dev{DevServer: "localhost:34115", DevHost: "192.168.1.10", DevPort: 8443, DevScheme: "https"}, want: "192.168.1.10:8443", wantURL: "https://192.168.1.10:8443", wantControlURL: "http://192.168.1.10:8443"},
+		{name: "https", dev: Dev{DevServer: "localhost:34115", DevScheme: "HTTPS"}, want: "localhost:34115", wantURL: "https://localhost:34115", wantControlURL: "http://localhost:34115"},
+		{name: "ipv6", dev: Dev{DevServer: "localhost:34115", DevHost: "::1"}, want: "[::1]:34115", wantURL: "http://[::1]:34115", wantControlURL: "http://[::1]:34115"},
+		{name: "same host and devhost", dev: Dev{DevServer: "localhost:34115", Host: "example.local", DevHost: "example.local"}, want: "example.local:34115", wantURL: "http://example.local:34115", wantControlURL: "http://example.local:34115"},
+		{name: "invalid devserver", dev: Dev{DevServer: "localhost"}, wantErr: "DevServer is not of the form 'host:port'"},
+		{name: "host and devhost", dev: Dev{DevServer: "localhost:34115", Host: "a", DevHost: "b"}, wantErr: "can't both be given"},
+		{name: "invalid devhost", dev: Dev{DevServer: "localhost:34115", DevHost: "-a"}, wantErr: "not a valid hostname"},
+		{name: "invalid devport", dev: Dev{DevServer: "localhost:34115", DevPort: 70000}, wantErr: "not a valid port"},
+		{name: "invalid devscheme", dev: Dev{DevServer: "localhost:34115", DevScheme: "ws"}, wantErr: "devscheme 'ws' is not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dev.processDevServer()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tt.dev.DevServer)
+			require.Equal(t, tt.wantURL, tt.dev.DevServerURL().String())
+			require.Equal(t, tt.wantControlURL, tt.dev.ControlURL().String())
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package dev
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
+)
+
+const (
+	// buildTimesWindow is the number of the last rebuilds whose average build time is tracked
+	buildTimesWindow = 10
+	// slowBuildMinimumSamples is the number of rebuilds needed before a rebuild is compared with their average
+	slowBuildMinimumSamples = 3
+	// slowBuildFactor is how many times the average a rebuild may take before it is logged as slow
+	slowBuildFactor = 2
+)
+
+// buildTimes tracks the rolling average of the build times of the rebuilds, to spot ones which are slower than
+// usual. The initial build isn't tracked, as it usually takes longer without the build cache
+type buildTimes struct {
+	durations []time.Duration
+}
+
+// average returns the average build time of the tracked rebuilds
+func (b *buildTimes) average() time.Duration {
+	if len(b.durations) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, duration := range b.durations {
+		total += duration
+	}
+	return total / time.Duration(len(b.durations))
+}
+
+// add tracks the build time of a rebuild. It returns the average of the previous rebuilds and whether this one took
+// more than slowBuildFactor times as long, once there have been slowBuildMinimumSamples of them
+func (b *buildTimes) add(duration time.Duration) (average time.Duration, slow bool) {
+	average = b.average()
+	slow = len(b.durations) >= slowBuildMinimumSamples && duration > slowBuildFactor*average
+
+	b.durations = append(b.durations, duration)
+	if len(b.durations) > buildTimesWindow {
+		b.durations = b.durations[len(b.durations)-buildTimesWindow:]
+	}
+	return average, slow
+}
+
+// logBuildDuration logs how long the build took. For rebuilds, it is tracked in rebuildTimes and a warning is logged
+// if it took more than slowBuildFactor times the average
+func logBuildDuration(duration time.Duration, rebuildTimes *buildTimes) {
+	if rebuildTimes == nil {
+		logutils.LogDuration(duration, "Build completed in %s", formatBuildDuration(duration))
+		return
+	}
+	logutils.LogDuration(duration, "Rebuild completed in %s", formatBuildDuration(duration))
+	if average, slow := rebuildTimes.add(duration); slow {
+		logutils.LogDarkYellow("The rebuild took more than %d times the average of %s, EG: because of a new dependency or a cleared build cache", slowBuildFactor, formatBuildDuration(average))
+	}
+}
+
+// formatBuildDuration formats the duration in seconds, EG: 1.8s
+func formatBuildDuration(duration time.Duration) string {
+	return fmt.Sprintf("%.1fs", duration.Seconds())
+}
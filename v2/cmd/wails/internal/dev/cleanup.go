@@ -0,0 +1,85 @@
+package dev
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
+	"github.com/wailsapp/wails/v2/internal/process"
+)
+
+// devCleanup stops the frontend DevWatcher commands and the application and removes its binary. It runs once,
+// whether dev exits normally, with an error or because of a signal
+type devCleanup struct {
+	lock      sync.Mutex
+	done      bool
+	closer    func()
+	processes []*process.Process
+	binary    string
+}
+
+// setCloser sets the function which stops the frontend DevWatcher commands. If the cleanup has already run, the
+// commands are stopped straight away
+func (c *devCleanup) setCloser(closer func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.done {
+		closer()
+		return
+	}
+	c.closer = closer
+}
+
+// setApplication sets the running processes of the application and its binary. If the cleanup has already run, the
+// processes are killed straight away, so that a restart which was in progress doesn't leave them behind
+func (c *devCleanup) setApplication(processes []*process.Process, binary string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.done {
+		if err := killProcessesAndCleanupBinary(processes, binary); err != nil {
+			logutils.LogDarkYellow("Unable to kill process and cleanup binary: %s", err)
+		}
+		return
+	}
+	c.processes = processes
+	c.binary = binary
+}
+
+// run kills the application, removes its binary and stops the frontend DevWatcher commands. Only the first call does
+// anything, later calls wait for it to finish
+func (c *devCleanup) run() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.done {
+		return nil
+	}
+	c.done = true
+
+	err := killProcessesAndCleanupBinary(c.processes, c.binary)
+	if c.closer != nil {
+		c.closer()
+	}
+	return err
+}
+
+// handleSignals stops dev on SIGINT and SIGTERM. While the watcher loop runs, the first signal asks it to quit by
+// forwarding the signal to quitChannel, so that dev exits normally. A second signal, or dev not having exited after
+// gracePeriod, runs the cleanup and exits. Before the watcher loop runs, the first signal does so straight away
+func handleSignals(signals <-chan os.Signal, quitChannel chan<- os.Signal, watching *atomic.Bool, cleanup *devCleanup, gracePeriod time.Duration, exit func(code int)) {
+	signal := <-signals
+	if watching.Load() {
+		quitChannel <- signal
+		select {
+		case <-signals:
+		case <-time.After(gracePeriod):
+		}
+	}
+
+	logutils.LogGreen("\nCaught %s, cleaning up", signal)
+	if err := cleanup.run(); err != nil {
+		logutils.LogDarkYellow("Unable to kill process and cleanup binary: %s", err)
+	}
+	exit(1)
+}
@@ -13,6 +13,8 @@ import (
 	"os/signal"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -35,21 +37,25 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/wailsapp/wails/v2/internal/fs"
 	"github.com/wailsapp/wails/v2/internal/process"
+	"github.com/wailsapp/wails/v2/internal/project"
 	"github.com/wailsapp/wails/v2/pkg/clilogger"
 	"github.com/wailsapp/wails/v2/pkg/commands/build"
 )
 
 const (
 	viteMinVersion = "v3.0.0"
-)
 
-func sliceToMap(input []string) map[string]struct{} {
-	result := map[string]struct{}{}
-	for _, value := range input {
-		result[value] = struct{}{}
-	}
-	return result
-}
+	// instanceStartDelay is the delay between starting app instances when using -instances
+	instanceStartDelay = 500 * time.Millisecond
+
+	// devReloadEventDelay gives the handlers of the wails:dev:reload event time to run before the application is
+	// restarted
+	devReloadEventDelay = 100 * time.Millisecond
+
+	// signalGracePeriod is the time, on top of -devshutdowntimeout, dev gets to exit normally after a signal before
+	// the cleanup is run by the signal handler
+	signalGracePeriod = 5 * time.Second
+)
 
 // Application runs the application in dev mode
 func Application(f *flags.Dev, logger *clilogger.CLILogger) error {
@@ -61,82 +67,74 @@ func Application(f *flags.Dev, logger *clilogger.CLILogger) error {
 		return err
 	}
 
-	if !f.SkipModTidy {
+	if f.Offline {
+		if err := goOffline(); err != nil {
+			return err
+		}
+	}
+
+	if !f.SkipModTidy && !f.DryRun {
 		// Run go mod tidy to ensure we're up-to-date
 		err = runCommand(cwd, false, f.Compiler, "mod", "tidy")
+		if err != nil && !f.Offline {
+			return fmt.Errorf("%w: if you are offline, use -offline to build with the module cache", err)
+		}
 		if err != nil {
-			return err
+			// The builds would fail the same way
+			logutils.LogDarkYellow("go mod tidy failed while offline, skipping it and continuing with the module cache")
+			f.SkipModTidy = true
 		}
 	}
 
-	buildOptions := f.GenerateBuildOptions()
-	buildOptions.Logger = logger
-
-	userTags, err := buildtags.Parse(f.Tags)
+	buildOptions, err := generateBuildOptions(f, logger)
 	if err != nil {
 		return err
 	}
-
 	projectConfig := f.ProjectConfig()
 
-	projectTags, err := buildtags.Parse(projectConfig.BuildTags)
-	if err != nil {
-		return err
+	if f.DryRun {
+		return dryRun(f, projectConfig, buildOptions, logger)
 	}
-	compiledTags := append(projectTags, userTags...)
-	buildOptions.UserTags = compiledTags
 
-	// Setup signal handler
-	quitChannel := make(chan os.Signal, 1)
-	signal.Notify(quitChannel, os.Interrupt, syscall.SIGTERM)
+	// The cleanup runs on every exit path, including signals, so no frontend DevWatcher commands or application
+	// processes are left behind
+	cleanup := &devCleanup{}
+	defer func() {
+		if err := cleanup.run(); err != nil {
+			logutils.LogDarkYellow("Unable to kill process and cleanup binary: %s", err)
+		}
+	}()
+
+	quitChannel, watching, stopSignals := handleDevSignals(f, cleanup)
+	defer stopSignals()
 	exitCodeChannel := make(chan int, 1)
 
 	// Build the frontend if requested, but ignore building the application itself.
 	ignoreFrontend := buildOptions.IgnoreFrontend
 	if !ignoreFrontend {
-		buildOptions.IgnoreApplication = true
-		if _, err := build.Build(buildOptions); err != nil {
+		if err := buildFrontend(buildOptions); err != nil {
 			return err
 		}
-		buildOptions.IgnoreApplication = false
 	}
 
-	legacyUseDevServerInsteadofCustomScheme := false
-	// frontend:dev:watcher command.
-	frontendDevAutoDiscovery := projectConfig.IsFrontendDevServerURLAutoDiscovery()
-	if command := projectConfig.DevWatcherCommand; command != "" {
-		closer, devServerURL, devServerViteVersion, err := runFrontendDevWatcherCommand(projectConfig.GetFrontendDir(), command, frontendDevAutoDiscovery, projectConfig.ViteServerTimeout)
-		if err != nil {
-			return err
-		}
-		if devServerURL != "" {
-			projectConfig.FrontendDevServerURL = devServerURL
-			f.FrontendDevServerURL = devServerURL
-		}
-		defer closer()
+	if f.AssetDir != "" && !fs.DirExists(f.AssetDir) {
+		return fmt.Errorf("the asset directory '%s' does not exist. Please check the -assetdir flag or the 'assetdir' setting in wails.json", f.AssetDir)
+	}
 
-		if devServerViteVersion != "" && semver.Compare(devServerViteVersion, viteMinVersion) < 0 {
-			logutils.LogRed("Please upgrade your Vite Server to at least '%s' future Wails versions will require at least Vite '%s'", viteMinVersion, viteMinVersion)
-			time.Sleep(3 * time.Second)
-			legacyUseDevServerInsteadofCustomScheme = true
-		}
-	} else if frontendDevAutoDiscovery {
-		return fmt.Errorf("unable to auto discover frontend:dev:serverUrl without a frontend:dev:watcher command, please either set frontend:dev:watcher or remove the auto discovery from frontend:dev:serverUrl")
+	frontend, legacyUseDevServerInsteadofCustomScheme, err := startFrontendDevWatchers(f, projectConfig, cleanup)
+	if err != nil {
+		return err
 	}
 
 	// Do initial build but only for the application.
 	logger.Println("Building application for development...")
 	buildOptions.IgnoreFrontend = true
-	debugBinaryProcess, appBinary, err := restartApp(buildOptions, nil, f, exitCodeChannel, legacyUseDevServerInsteadofCustomScheme)
+	debugBinaryProcesses, appBinary, err := restartApp(buildOptions, nil, f, exitCodeChannel, legacyUseDevServerInsteadofCustomScheme, nil, nil)
 	buildOptions.IgnoreFrontend = ignoreFrontend || f.FrontendDevServerURL != ""
+	cleanup.setApplication(debugBinaryProcesses, appBinary)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := killProcessAndCleanupBinary(debugBinaryProcess, appBinary); err != nil {
-			logutils.LogDarkYellow("Unable to kill process and cleanup binary: %s", err)
-		}
-	}()
 
 	// open browser
 	if f.Browser {
@@ -151,6 +149,9 @@ func Application(f *flags.Dev, logger *clilogger.CLILogger) error {
 		logutils.LogGreen("Using Frontend DevServer URL: %s", f.FrontendDevServerURL)
 	}
 	logutils.LogGreen("Using reload debounce setting of %d milliseconds", f.Debounce)
+	if f.GoDebounce != f.Debounce {
+		logutils.LogGreen("Using rebuild debounce setting of %d milliseconds", f.GoDebounce)
+	}
 
 	// Show dev server URL in terminal after 3 seconds
 	go func() {
@@ -159,29 +160,28 @@ func Application(f *flags.Dev, logger *clilogger.CLILogger) error {
 	}()
 
 	// Watch for changes and trigger restartApp()
-	debugBinaryProcess, err = doWatcherLoop(cwd, projectConfig.ReloadDirectories, buildOptions, debugBinaryProcess, f, exitCodeChannel, quitChannel, f.DevServerURL(), legacyUseDevServerInsteadofCustomScheme)
+	watching.Store(true)
+	err = doWatcherLoop(cwd, projectConfig.ReloadDirectories, buildOptions, debugBinaryProcesses, f, exitCodeChannel, quitChannel, f.ControlURL(), legacyUseDevServerInsteadofCustomScheme, cleanup, frontend)
 	if err != nil {
 		return err
 	}
 
-	// Kill the current program if running and remove dev binary
-	if err := killProcessAndCleanupBinary(debugBinaryProcess, appBinary); err != nil {
+	// Kill the current program if running, remove dev binary and stop the frontend DevWatcher commands
+	if err := cleanup.run(); err != nil {
 		return err
 	}
 
-	// Reset the process and the binary so defer knows about it and is a nop.
-	debugBinaryProcess = nil
-	appBinary = ""
-
 	logutils.LogGreen("Development mode exited")
 
 	return nil
 }
 
-func killProcessAndCleanupBinary(process *process.Process, binary string) error {
-	if process != nil && process.Running {
-		if err := process.Kill(); err != nil {
-			return err
+func killProcessesAndCleanupBinary(processes []*process.Process, binary string) error {
+	for _, process := range processes {
+		if process != nil && process.Running {
+			if err := process.Kill(); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -194,6 +194,19 @@ func killProcessAndCleanupBinary(process *process.Process, binary string) error
 	return nil
 }
 
+// goOffline makes the go commands started by dev use the module cache only. -mod=mod allows them to update go.mod
+// and go.sum from the cache, unless another -mod has been given
+func goOffline() error {
+	if err := os.Setenv("GOPROXY", "off"); err != nil {
+		return err
+	}
+	goFlags := os.Getenv("GOFLAGS")
+	if strings.Contains(goFlags, "-mod=") {
+		return nil
+	}
+	return os.Setenv("GOFLAGS", strings.TrimSpace(goFlags+" -mod=mod"))
+}
+
 func runCommand(dir string, exitOnError bool, command string, args ...string) error {
 	logutils.LogGreen("Executing: " + command + " " + strings.Join(args, " "))
 	cmd := exec.Command(command, args...)
@@ -210,96 +223,389 @@ func runCommand(dir string, exitOnError bool, command string, args ...string) er
 	return nil
 }
 
-// runFrontendDevWatcherCommand will run the `frontend:dev:watcher` command if it was given, ex- `npm run dev`
-func runFrontendDevWatcherCommand(frontendDirectory string, devCommand string, discoverViteServerURL bool, viteServerTimeout int) (func(), string, string, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	scanner := NewStdoutScanner()
-	cmdSlice := strings.Split(devCommand, " ")
-	cmd := exec.CommandContext(ctx, cmdSlice[0], cmdSlice[1:]...)
-	cmd.Stderr = os.Stderr
-	cmd.Stdout = scanner
-	cmd.Dir = frontendDirectory
-	setParentGID(cmd)
-
-	if err := cmd.Start(); err != nil {
-		cancel()
-		return nil, "", "", fmt.Errorf("unable to start frontend DevWatcher: %w", err)
+// runFrontendDevWatcherCommands will run the `frontend:dev:watcher` and `frontend:dev:watchers` commands if they
+// were given, ex- `npm run dev`. The output of all commands is scanned for the Vite server URL and the dev server
+// which is running. If a command can't be started, the commands which have already been started are stopped. The
+// commands are stopped by the cleanup, which is set up before the first one is started. When stopped, the commands get
+// shutdownTimeout to exit before they are killed.
+func runFrontendDevWatcherCommands(frontendDirectory string, devCommands []string, discoverViteServerURL bool, viteServerTimeout int, shutdownTimeout time.Duration, cleanup *devCleanup) (*frontendDevWatchers, string, devServerInfo, error) {
+	frontend := newFrontendDevWatchers(frontendDirectory, devCommands, shutdownTimeout)
+	cleanup.setCloser(frontend.close)
+
+	if err := frontend.start(); err != nil {
+		return nil, "", devServerInfo{}, err
 	}
 
 	var viteServerURL string
 	if discoverViteServerURL {
 		select {
-		case serverURL := <-scanner.ViteServerURLChan:
+		case serverURL := <-frontend.scanner.ViteServerURLChan:
 			viteServerURL = serverURL
 		case <-time.After(time.Second * time.Duration(viteServerTimeout)):
-			cancel()
-			return nil, "", "", fmt.Errorf("failed to find Vite server URL: Timed out waiting for Vite to output a URL after %d seconds", viteServerTimeout)
+			frontend.close()
+			return nil, "", devServerInfo{}, fmt.Errorf("failed to find Vite server URL: Timed out waiting for Vite to output a URL after %d seconds", viteServerTimeout)
 		}
 	}
 
-	viteVersion := ""
 	select {
-	case version := <-scanner.ViteServerVersionC:
-		viteVersion = version
-
+	case <-frontend.scanner.FrameworkDetectedC:
 	case <-time.After(time.Second * 5):
-		// That's fine, then most probably it was not vite that was running
+		// That's fine, then most probably the command doesn't start a dev server
+	}
+
+	for _, devCommand := range devCommands {
+		logutils.LogGreen("Running frontend DevWatcher command: '%s'", devCommand)
+	}
+
+	return frontend, viteServerURL, frontend.scanner.DevServer(), nil
+}
+
+// frontendDevWatchers are the frontend DevWatcher commands. They keep running across the rebuilds of the application,
+// unless they are restarted with restart
+type frontendDevWatchers struct {
+	frontendDirectory string
+	devCommands       []string
+	shutdownTimeout   time.Duration
+	scanner           *stdoutScanner
+
+	// The lock is held while the commands are started, so that a signal doesn't stop them halfway
+	lock     sync.Mutex
+	watchers []*devWatcher
+	cancel   context.CancelFunc
+	closed   bool
+}
+
+func newFrontendDevWatchers(frontendDirectory string, devCommands []string, shutdownTimeout time.Duration) *frontendDevWatchers {
+	return &frontendDevWatchers{
+		frontendDirectory: frontendDirectory,
+		devCommands:       devCommands,
+		shutdownTimeout:   shutdownTimeout,
+		scanner:           NewStdoutScanner(),
 	}
+}
+
+// start starts the commands. If one can't be started, the commands which have already been started are stopped
+func (w *frontendDevWatchers) start() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.startWatchers()
+}
+
+// restart stops the commands and starts them again, for -restartfrontendonrebuild
+func (w *frontendDevWatchers) restart() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.stopWatchers()
+	return w.startWatchers()
+}
+
+// close stops the commands for good. Only the first call does anything
+func (w *frontendDevWatchers) close() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return
+	}
+	w.closed = true
+	w.stopWatchers()
+}
+
+// pids returns the process IDs of the running commands
+func (w *frontendDevWatchers) pids() []int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return lo.Map(w.watchers, func(watcher *devWatcher, _ int) int {
+		return watcher.cmd.Process.Pid
+	})
+}
+
+func (w *frontendDevWatchers) startWatchers() error {
+	if w.closed {
+		return nil
+	}
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(context.Background())
+	for _, devCommand := range w.devCommands {
+		watcher, err := startDevWatcher(ctx, w.frontendDirectory, devCommand, w.scanner)
+		if err != nil {
+			w.stopWatchers()
+			return err
+		}
+		w.watchers = append(w.watchers, watcher)
+	}
+	return nil
+}
+
+func (w *frontendDevWatchers) stopWatchers() {
+	var stopping sync.WaitGroup
+	for _, watcher := range w.watchers {
+		stopping.Add(1)
+		go func(watcher *devWatcher) {
+			defer stopping.Done()
+			watcher.stop(w.shutdownTimeout)
+		}(watcher)
+	}
+	stopping.Wait()
+	if w.cancel != nil {
+		w.cancel()
+	}
+	for _, watcher := range w.watchers {
+		watcher.wg.Wait()
+	}
+	w.watchers = nil
+}
+
+const (
+	devWatcherRunning   int32 = 0
+	devWatcherCanceling int32 = 1
+	devWatcherStopped   int32 = 2
+)
+
+// devWatcher is a running frontend DevWatcher command
+type devWatcher struct {
+	command string
+	cmd     *exec.Cmd
+	state   int32
+	wg      sync.WaitGroup
+}
+
+func startDevWatcher(ctx context.Context, frontendDirectory string, devCommand string, scanner *stdoutScanner) (*devWatcher, error) {
+	cmdSlice := strings.Split(devCommand, " ")
+	cmd := exec.CommandContext(ctx, cmdSlice[0], cmdSlice[1:]...)
+	cmd.Stderr = os.Stderr
+	cmd.Stdout = scanner
+	cmd.Dir = frontendDirectory
+	setParentGID(cmd)
 
-	logutils.LogGreen("Running frontend DevWatcher command: '%s'", devCommand)
-	var wg sync.WaitGroup
-	wg.Add(1)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start frontend DevWatcher '%s': %w", devCommand, err)
+	}
 
-	const (
-		stateRunning   int32 = 0
-		stateCanceling int32 = 1
-		stateStopped   int32 = 2
-	)
-	state := stateRunning
+	watcher := &devWatcher{
+		command: devCommand,
+		cmd:     cmd,
+		state:   devWatcherRunning,
+	}
+	watcher.wg.Add(1)
 	go func() {
 		if err := cmd.Wait(); err != nil {
-			wasRunning := atomic.CompareAndSwapInt32(&state, stateRunning, stateStopped)
+			wasRunning := atomic.CompareAndSwapInt32(&watcher.state, devWatcherRunning, devWatcherStopped)
 			if err.Error() != "exit status 1" && wasRunning {
 				logutils.LogRed("Error from DevWatcher '%s': %s", devCommand, err.Error())
 			}
 		}
-		atomic.StoreInt32(&state, stateStopped)
-		wg.Done()
+		atomic.StoreInt32(&watcher.state, devWatcherStopped)
+		watcher.wg.Done()
+	}()
+	return watcher, nil
+}
+
+// stop terminates the command if it's still running, it's killed if it hasn't exited after the timeout
+func (w *devWatcher) stop(timeout time.Duration) {
+	if atomic.CompareAndSwapInt32(&w.state, devWatcherRunning, devWatcherCanceling) {
+		killProc(w.cmd, w.command, timeout)
+	}
+}
+
+// restartFrontendDevWatchers restarts the frontend DevWatcher commands with a rebuild of the application if restart is
+// set. By default they keep running, which is logged if verbose
+func restartFrontendDevWatchers(frontend *frontendDevWatchers, restart bool, verbose bool) {
+	if frontend == nil {
+		return
+	}
+	if !restart {
+		if verbose {
+			logutils.LogDarkYellow("Frontend DevWatcher kept running across the rebuild (PID %s)", strings.Join(lo.Map(frontend.pids(), func(pid int, _ int) string {
+				return strconv.Itoa(pid)
+			}), ", "))
+		}
+		return
+	}
+	logutils.LogGreen("Restarting the frontend DevWatcher commands")
+	if err := frontend.restart(); err != nil {
+		logutils.LogRed("Unable to restart the frontend DevWatcher commands: %s", err)
+	}
+}
+
+// formatBuildOptions returns the options which are passed to build.Build, to help diagnose differences between
+// dev and production builds
+func formatBuildOptions(buildOptions *build.Options) string {
+	mode := "dev"
+	switch buildOptions.Mode {
+	case build.Production:
+		mode = "production"
+	case build.Debug:
+		mode = "debug"
+	}
+
+	var result strings.Builder
+	result.WriteString("Build options:")
+	option := func(name string, value interface{}) {
+		result.WriteString(fmt.Sprintf("\n  %-15s %v", name+":", value))
+	}
+	option("Mode", mode)
+	option("Platform", buildOptions.Platform+"/"+buildOptions.Arch)
+	option("Compiler", buildOptions.Compiler)
+	option("Tags", strings.Join(buildOptions.UserTags, ","))
+	option("FrontendTags", strings.Join(buildOptions.FrontendTags, ","))
+	option("LDFlags", buildOptions.LDFlags)
+	option("TrimPath", buildOptions.TrimPath)
+	option("RaceDetector", buildOptions.RaceDetector)
+	option("SkipModTidy", buildOptions.SkipModTidy)
+	option("SkipBindings", buildOptions.SkipBindings)
+	option("ForceBuild", buildOptions.ForceBuild)
+	return result.String()
+}
+
+// BuildFrontend builds the frontend assets like Application and exits, without building or running the application,
+// EG: to debug the asset pipeline. The frontend:dev:watcher commands are started until their dev server has been
+// detected, then they are stopped again
+func BuildFrontend(f *flags.Dev, logger *clilogger.CLILogger) error {
+	buildOptions, err := generateBuildOptions(f, logger)
+	if err != nil {
+		return err
+	}
+	// Generating the bindings compiles the application, so the existing ones are used
+	buildOptions.SkipBindings = true
+
+	cleanup := &devCleanup{}
+	defer func() {
+		if err := cleanup.run(); err != nil {
+			logutils.LogDarkYellow("Unable to stop the frontend DevWatcher: %s", err)
+		}
+	}()
+	_, _, stopSignals := handleDevSignals(f, cleanup)
+	defer stopSignals()
+
+	if err := buildFrontend(buildOptions); err != nil {
+		return err
+	}
+	if _, _, err := startFrontendDevWatchers(f, f.ProjectConfig(), cleanup); err != nil {
+		return err
+	}
+	logutils.LogGreen("Frontend build completed")
+	return nil
+}
+
+// generateBuildOptions returns the build options of the flags with the build tags of the project and the flags
+func generateBuildOptions(f *flags.Dev, logger *clilogger.CLILogger) (*build.Options, error) {
+	buildOptions := f.GenerateBuildOptions()
+	buildOptions.Logger = logger
+
+	userTags, err := buildtags.Parse(f.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	projectTags, err := buildtags.Parse(f.ProjectConfig().BuildTags)
+	if err != nil {
+		return nil, err
+	}
+	compiledTags := append(projectTags, userTags...)
+	buildOptions.UserTags = compiledTags
+
+	buildOptions.FrontendTags, err = buildtags.Parse(f.FrontendTags)
+	if err != nil {
+		return nil, err
+	}
+	return buildOptions, nil
+}
+
+// handleDevSignals runs the cleanup on SIGINT and SIGTERM, see handleSignals. The signals are forwarded to the
+// returned channel once watching has been set. stop stops handling them
+func handleDevSignals(f *flags.Dev, cleanup *devCleanup) (quitChannel chan os.Signal, watching *atomic.Bool, stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	quitChannel = make(chan os.Signal, 1)
+	watching = &atomic.Bool{}
+	gracePeriod := time.Duration(f.DevShutdownTimeout)*time.Second + signalGracePeriod
+	go handleSignals(signals, quitChannel, watching, cleanup, gracePeriod, os.Exit)
+	return quitChannel, watching, func() {
+		signal.Stop(signals)
+	}
+}
+
+// buildFrontend builds the frontend, but not the application
+func buildFrontend(buildOptions *build.Options) error {
+	buildOptions.IgnoreApplication = true
+	defer func() {
+		buildOptions.IgnoreApplication = false
 	}()
+	_, err := build.Build(buildOptions)
+	return err
+}
 
-	return func() {
-		if atomic.CompareAndSwapInt32(&state, stateRunning, stateCanceling) {
-			killProc(cmd, devCommand)
+// startFrontendDevWatchers starts the frontend:dev:watcher commands, if there are any, and waits for the dev server
+// they start to be detected. The detected dev server URL is set as the frontend dev server URL. It returns whether the
+// Vite server is too old for the custom scheme, so the dev server has to be used instead
+func startFrontendDevWatchers(f *flags.Dev, projectConfig *project.Project, cleanup *devCleanup) (*frontendDevWatchers, bool, error) {
+	legacyUseDevServerInsteadofCustomScheme := false
+	frontendDevAutoDiscovery := projectConfig.IsFrontendDevServerURLAutoDiscovery()
+	commands := projectConfig.GetDevWatcherCommands()
+	if len(commands) == 0 {
+		if frontendDevAutoDiscovery {
+			return nil, false, fmt.Errorf("unable to auto discover frontend:dev:serverUrl without a frontend:dev:watcher command, please either set frontend:dev:watcher or remove the auto discovery from frontend:dev:serverUrl")
 		}
-		cancel()
-		wg.Wait()
-	}, viteServerURL, viteVersion, nil
+		return nil, false, nil
+	}
+
+	frontend, devServerURL, devServer, err := runFrontendDevWatcherCommands(projectConfig.GetFrontendDir(), commands, frontendDevAutoDiscovery, projectConfig.ViteServerTimeout, time.Duration(f.DevShutdownTimeout)*time.Second, cleanup)
+	if err != nil {
+		return nil, false, err
+	}
+	if devServerURL != "" {
+		projectConfig.FrontendDevServerURL = devServerURL
+		f.FrontendDevServerURL = devServerURL
+	}
+
+	if devServer.Framework != "" {
+		logutils.LogGreen("%s", devServer)
+	} else {
+		logutils.LogDarkYellow("%s", devServer)
+	}
+	if devServer.Framework == "Vite" && semver.Compare(devServer.Version, viteMinVersion) < 0 {
+		logutils.LogRed("Please upgrade your Vite Server to at least '%s' future Wails versions will require at least Vite '%s'", viteMinVersion, viteMinVersion)
+		time.Sleep(3 * time.Second)
+		legacyUseDevServerInsteadofCustomScheme = true
+	}
+	return frontend, legacyUseDevServerInsteadofCustomScheme, nil
 }
 
-// restartApp does the actual rebuilding of the application when files change
-func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process, f *flags.Dev, exitCodeChannel chan int, legacyUseDevServerInsteadofCustomScheme bool) (*process.Process, string, error) {
+// restartApp rebuilds the application when files change and restarts it. beforeRestart is called after a successful
+// build, before the running application is stopped. It then starts `f.Instances` processes of the new binary, the
+// first of which is the primary instance: only its exit code is reported on exitCodeChannel. The build times of
+// rebuilds are tracked in rebuildTimes, which is nil for the initial build
+func restartApp(buildOptions *build.Options, debugBinaryProcesses []*process.Process, f *flags.Dev, exitCodeChannel chan int, legacyUseDevServerInsteadofCustomScheme bool, beforeRestart func(), rebuildTimes *buildTimes) ([]*process.Process, string, error) {
+	if buildOptions.Verbosity == build.VERBOSE {
+		logutils.LogDarkYellow(formatBuildOptions(buildOptions))
+	}
+	buildStart := time.Now()
 	appBinary, err := build.Build(buildOptions)
+	buildDuration := time.Since(buildStart)
 	println()
 	if err != nil {
 		logutils.LogRed("Build error - " + err.Error())
 
 		msg := "Continuing to run current version"
-		if debugBinaryProcess == nil {
+		if len(debugBinaryProcesses) == 0 {
 			msg = "No version running, build will be retriggered as soon as changes have been detected"
 		}
 		logutils.LogDarkYellow(msg)
 		return nil, "", nil
 	}
+	logBuildDuration(buildDuration, rebuildTimes)
+
+	if beforeRestart != nil && len(debugBinaryProcesses) != 0 {
+		beforeRestart()
+	}
 
-	// Kill existing binary if need be
-	if debugBinaryProcess != nil {
+	// Kill existing binaries if need be
+	for _, debugBinaryProcess := range debugBinaryProcesses {
 		killError := debugBinaryProcess.Kill()
 
 		if killError != nil {
 			buildOptions.Logger.Fatal("Unable to kill debug binary (PID: %d)!", debugBinaryProcess.PID())
 		}
-
-		debugBinaryProcess = nil
 	}
 
 	// parse appargs if any
@@ -313,6 +619,10 @@ func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process
 	os.Setenv("assetdir", f.AssetDir)
 	os.Setenv("devserver", f.DevServer)
 	os.Setenv("frontenddevserverurl", f.FrontendDevServerURL)
+	os.Setenv("dumpassets", strconv.FormatBool(f.DumpAssets))
+	if f.SafeMode {
+		os.Setenv("WAILS_SAFEMODE", "true")
+	}
 
 	// Start up new binary with correct args
 
@@ -336,30 +646,85 @@ func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process
 		args = newArgs
 	}
 
-	logutils.LogGreen("Executing: " + command + " " + strings.Join(args, " "))
-	newProcess := process.NewProcess(command, args...)
-	err = newProcess.Start(exitCodeChannel)
-	if err != nil {
-		// Remove binary
-		if fs.FileExists(appBinary) {
-			deleteError := fs.DeleteFile(appBinary)
-			if deleteError != nil {
-				buildOptions.Logger.Fatal("Unable to delete app binary: " + appBinary)
+	var newProcesses []*process.Process
+	for instance := 1; instance <= f.Instances; instance++ {
+		instanceExitCodeChannel := exitCodeChannel
+		if instance > 1 {
+			// Stagger the instances so that the primary instance is up before the others start,
+			// and don't let a secondary instance exiting end the dev session
+			time.Sleep(instanceStartDelay)
+			instanceExitCodeChannel = make(chan int, 1)
+			logutils.LogGreen("Starting instance %d of %d", instance, f.Instances)
+		}
+
+		logutils.LogGreen("Executing: " + command + " " + strings.Join(args, " "))
+		newProcess := process.NewProcess(command, args...)
+		os.Setenv("launchtime", strconv.FormatInt(time.Now().UnixNano(), 10))
+		environment, err := envFileEnvironment(f.EnvFilePath())
+		if err != nil {
+			logutils.LogRed("Unable to load the env file, starting without its variables: %s", err.Error())
+		}
+		newProcess.SetEnv(environment)
+		err = newProcess.Start(instanceExitCodeChannel)
+		if err != nil {
+			// Kill any instances already started and remove binary
+			for _, p := range newProcesses {
+				_ = p.Kill()
 			}
+			if fs.FileExists(appBinary) {
+				deleteError := fs.DeleteFile(appBinary)
+				if deleteError != nil {
+					buildOptions.Logger.Fatal("Unable to delete app binary: " + appBinary)
+				}
+			}
+			buildOptions.Logger.Fatal("Unable to start application: %s", err.Error())
 		}
-		buildOptions.Logger.Fatal("Unable to start application: %s", err.Error())
+		newProcesses = append(newProcesses, newProcess)
 	}
 
-	return newProcess, appBinary, nil
+	return newProcesses, appBinary, nil
 }
 
 // doWatcherLoop is the main watch loop that runs while dev is active
-func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, debugBinaryProcess *process.Process, f *flags.Dev, exitCodeChannel chan int, quitChannel chan os.Signal, devServerURL *url.URL, legacyUseDevServerInsteadofCustomScheme bool) (*process.Process, error) {
+func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, debugBinaryProcesses []*process.Process, f *flags.Dev, exitCodeChannel chan int, quitChannel chan os.Signal, controlURL *url.URL, legacyUseDevServerInsteadofCustomScheme bool, cleanup *devCleanup, frontend *frontendDevWatchers) error {
 	// create the project files watcher
-	watcher, err := initialiseWatcher(cwd, reloadDirs)
+	watchDirs := f.WatchDirectories()
+	pollInterval := time.Duration(f.PollInterval) * time.Millisecond
+	var watcher *fsnotify.Watcher
+	var events <-chan fsnotify.Event
+	var poller *directoryPoller
+	var skippedDirs int
+	var err error
+	rebuildTimes := &buildTimes{}
+	if f.PollWatcher {
+		var dirs []string
+		dirs, skippedDirs, err = watchedDirectories(cwd, reloadDirs, watchDirs, f.ReloadDirsMaxDepth, f.WatchAll)
+		if err == nil {
+			// The watcher stays empty, so that it can be handled like in the default mode
+			watcher, err = fsnotify.NewWatcher()
+		}
+		if err == nil {
+			logutils.LogGreen("Polling %d directories for changes every %s", len(dirs), pollInterval)
+			poller = newDirectoryPoller(dirs, pollInterval)
+			defer poller.Close()
+			events = poller.Events
+		}
+	} else {
+		watcher, skippedDirs, err = initialiseWatcher(cwd, reloadDirs, watchDirs, f.ReloadDirsMaxDepth, f.WatchAll)
+		var limitErr *watchLimitError
+		if errors.As(err, &limitErr) {
+			logutils.LogRed("Watching %d directories, the remaining %d are polled every %s instead: %s", limitErr.added, len(limitErr.remaining), pollInterval, limitErr)
+			poller = newDirectoryPoller(limitErr.remaining, pollInterval)
+			defer poller.Close()
+			events = mergeEvents(watcher.Events, poller.Events)
+			err = nil
+		} else if err == nil {
+			events = watcher.Events
+		}
+	}
 	if err != nil {
 		logutils.LogRed("Unable to create filesystem watcher. Reloads will not occur.")
-		return nil, err
+		return err
 	}
 
 	defer func(watcher *fsnotify.Watcher) {
@@ -370,9 +735,29 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 	}(watcher)
 
 	logutils.LogGreen("Watching (sub)/directory: %s", cwd)
+	for _, dir := range watchDirs {
+		logutils.LogGreen("Watching (sub)/directory: %s", dir)
+	}
+	if skippedDirs != 0 {
+		logutils.LogGreen("Skipped %d directories matching .gitignore, dot or build directories, use -watchall to watch them", skippedDirs)
+	}
+	isIgnoredDir := newDirectoryIgnorer(cwd, watchDirs, f.WatchAll)
+
+	// watchDir watches the directory. It's polled with -pollwatcher or once the limit of watches has been reached
+	watchDir := func(dir string) (polled bool, err error) {
+		if f.PollWatcher {
+			poller.Add(dir)
+			return true, nil
+		}
+		err = watcher.Add(dir)
+		if poller != nil && (errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE)) {
+			poller.Add(dir)
+			return true, nil
+		}
+		return false, err
+	}
 
 	// Main Loop
-	extensionsThatTriggerARebuild := sliceToMap(strings.Split(f.Extensions, ","))
 	var dirsThatTriggerAReload []string
 	for _, dir := range strings.Split(f.ReloadDirs, ",") {
 		if dir == "" {
@@ -384,27 +769,41 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 			continue
 		}
 		dirsThatTriggerAReload = append(dirsThatTriggerAReload, thePath)
-		err = watcher.Add(thePath)
-		if err != nil {
-			logutils.LogRed("Unable to watch path: %s due to error %v", thePath, err)
-		} else {
+		polled, err := watchDir(thePath)
+		switch {
+		case err != nil:
+			logutils.LogRed("Unable to watch path: %s due to error %v", thePath, watchError(err))
+		case polled:
+			logutils.LogGreen("Polling (sub)/directory: %s", thePath)
+		default:
 			logutils.LogGreen("Watching (sub)/directory: %s", thePath)
 		}
 	}
 
+	depthLimitedDirs := append(append([]string{}, dirsThatTriggerAReload...), watchDirs...)
+
 	quit := false
-	interval := time.Duration(f.Debounce) * time.Millisecond
-	timer := time.NewTimer(interval)
+	// Go changes and asset changes are debounced separately, so a series of Go edits results in a single rebuild
+	// while asset changes are still reloaded quickly
+	reloadInterval := time.Duration(f.Debounce) * time.Millisecond
+	reloadTimer := time.NewTimer(reloadInterval)
+	rebuildInterval := time.Duration(f.GoDebounce) * time.Millisecond
+	rebuildTimer := time.NewTimer(rebuildInterval)
 	rebuild := false
 	reload := false
 	assetDir := ""
 	changedPaths := map[string]struct{}{}
+	// The files which triggered the pending rebuild and full reload
+	rebuildPaths := map[string]struct{}{}
+	reloadPaths := map[string]struct{}{}
 
 	// If we are using an external dev server, the reloading of the frontend part can be skipped or if the user requested it
 	skipAssetsReload := f.FrontendDevServerURL != "" || f.NoReload
 
-	assetDirURL := joinPath(devServerURL, "/wails/assetdir")
-	reloadURL := joinPath(devServerURL, "/wails/reload")
+	assetDirURL := joinPath(controlURL, "/wails/assetdir")
+	reloadURL := joinPath(controlURL, "/wails/reload")
+	reloadAssetsURL := joinPath(controlURL, "/wails/reloadassets")
+	devReloadURL := joinPath(controlURL, "/wails/devreload")
 	for !quit {
 		// reload := false
 		select {
@@ -414,19 +813,7 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 			}
 		case err := <-watcher.Errors:
 			logutils.LogDarkYellow(err.Error())
-		case item := <-watcher.Events:
-			isEligibleFile := func(fileName string) bool {
-				// Iterate all file patterns
-				ext := filepath.Ext(fileName)
-				if ext != "" {
-					ext = ext[1:]
-					if _, exists := extensionsThatTriggerARebuild[ext]; exists {
-						return true
-					}
-				}
-				return false
-			}
-
+		case item := <-events:
 			// Handle write operations
 			if item.Op&fsnotify.Write == fsnotify.Write {
 				// Ignore directories
@@ -435,24 +822,41 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 					continue
 				}
 
-				if isEligibleFile(itemName) {
+				action := f.WatchAction(itemName)
+				if action == flags.WatchActionRebuild {
 					rebuild = true
-					timer.Reset(interval)
+					rebuildPaths[itemName] = struct{}{}
+					rebuildTimer.Reset(rebuildInterval)
 					continue
 				}
 
-				for _, reloadDir := range dirsThatTriggerAReload {
-					if strings.HasPrefix(itemName, reloadDir) {
-						reload = true
-						break
-					}
+				// Only rebuilds are triggered by the files of the watch directories
+				if action == flags.WatchActionIgnore || isInDirectory(itemName, watchDirs) {
+					continue
 				}
 
-				if !reload {
-					changedPaths[filepath.Dir(itemName)] = struct{}{}
+				switch action {
+				case flags.WatchActionFullReload:
+					reload = true
+					reloadPaths[itemName] = struct{}{}
+				case flags.WatchActionReload:
+					changedPaths[itemName] = struct{}{}
+				default:
+					for _, reloadDir := range dirsThatTriggerAReload {
+						if strings.HasPrefix(itemName, reloadDir) {
+							reload = true
+							break
+						}
+					}
+
+					if reload {
+						reloadPaths[itemName] = struct{}{}
+					} else {
+						changedPaths[itemName] = struct{}{}
+					}
 				}
 
-				timer.Reset(interval)
+				reloadTimer.Reset(reloadInterval)
 			}
 
 			// Handle new fs entries that are created
@@ -460,76 +864,117 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 				// If this is a folder, add it to our watch list
 				if fs.DirExists(item.Name) {
 					// node_modules is BANNED!
-					if !strings.Contains(item.Name, "node_modules") {
-						err := watcher.Add(item.Name)
+					tooDeep := lo.ContainsBy(depthLimitedDirs, func(dir string) bool {
+						return exceedsDepth(dir, item.Name, f.ReloadDirsMaxDepth)
+					})
+					if !strings.Contains(item.Name, "node_modules") && !tooDeep && !isIgnoredDir(item.Name) {
+						polled, err := watchDir(item.Name)
+						if polled {
+							logutils.LogGreen("Added new directory to poller: %s", item.Name)
+							continue
+						}
+						if errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE) {
+							logutils.LogRed("Unable to watch new directory %s: %s", item.Name, watchError(err))
+							continue
+						}
 						if err != nil {
 							buildOptions.Logger.Fatal("%s", err.Error())
 						}
 						logutils.LogGreen("Added new directory to watcher: %s", item.Name)
 					}
-				} else if isEligibleFile(item.Name) {
+				} else if f.WatchAction(item.Name) == flags.WatchActionRebuild {
 					// Handle creation of new file.
 					// Note: On some platforms an update to a file is represented as
 					// REMOVE -> CREATE instead of WRITE, so this is not only new files
 					// but also updates to existing files
 					rebuild = true
-					timer.Reset(interval)
+					rebuildPaths[item.Name] = struct{}{}
+					rebuildTimer.Reset(rebuildInterval)
 					continue
 				}
 			}
-		case <-timer.C:
-			if rebuild {
-				rebuild = false
-				if f.NoGoRebuild {
-					logutils.LogGreen("[Rebuild triggered] skipping due to flag -nogorebuild")
-				} else {
-					logutils.LogGreen("[Rebuild triggered] files updated")
-					// Try and build the app
+		case <-rebuildTimer.C:
+			if !rebuild {
+				continue
+			}
+			rebuild = false
+			paths := sortedPaths(rebuildPaths, changedPaths)
+			rebuildPaths = map[string]struct{}{}
+			if f.NoGoRebuild {
+				logutils.LogGreen("[Rebuild triggered] skipping due to flag -nogorebuild")
+				continue
+			}
+			logutils.LogGreen("[Rebuild triggered] files updated")
+			// Try and build the app
 
-					newBinaryProcess, _, err := restartApp(buildOptions, debugBinaryProcess, f, exitCodeChannel, legacyUseDevServerInsteadofCustomScheme)
-					if err != nil {
-						logutils.LogRed("Error during build: %s", err.Error())
-						continue
-					}
-					// If we have a new process, saveConfig it
-					if newBinaryProcess != nil {
-						debugBinaryProcess = newBinaryProcess
-					}
+			beforeRestart := func() {
+				notifyDevReload(devReloadURL, paths)
+				restartFrontendDevWatchers(frontend, f.RestartFrontend, buildOptions.Verbosity == build.VERBOSE)
+			}
+			newBinaryProcesses, appBinary, err := restartApp(buildOptions, debugBinaryProcesses, f, exitCodeChannel, legacyUseDevServerInsteadofCustomScheme, beforeRestart, rebuildTimes)
+			if err != nil {
+				logutils.LogRed("Error during build: %s", err.Error())
+				continue
+			}
+			// A failed build keeps the current version running, which still needs the pending reload
+			if len(newBinaryProcesses) == 0 {
+				continue
+			}
+			debugBinaryProcesses = newBinaryProcesses
+			cleanup.setApplication(debugBinaryProcesses, appBinary)
+
+			// The restarted application loads the frontend again, so a pending reload is not needed anymore
+			if !reloadTimer.Stop() {
+				select {
+				case <-reloadTimer.C:
+				default:
 				}
 			}
-
+			reload = false
+			changedPaths = map[string]struct{}{}
+			reloadPaths = map[string]struct{}{}
+		case <-reloadTimer.C:
+			var changedAssets []string
 			if !skipAssetsReload && len(changedPaths) != 0 {
+				var assetDirErr error
 				if assetDir == "" {
-					resp, err := http.Get(assetDirURL)
-					if err != nil {
-						logutils.LogRed("Error during retrieving assetdir: %s", err.Error())
-					} else {
-						content, err := io.ReadAll(resp.Body)
-						if err != nil {
-							logutils.LogRed("Error reading assetdir from devserver: %s", err.Error())
-						} else {
-							assetDir = conv.BytesToString(content)
-						}
-						resp.Body.Close()
-					}
+					assetDir, assetDirErr = fetchAssetDir(assetDirURL)
 				}
 
 				if assetDir != "" {
-					for thePath := range changedPaths {
-						if strings.HasPrefix(thePath, assetDir) {
-							reload = true
-							break
-						}
+					changedAssets = assetURLPaths(assetDir, changedPaths)
+					if len(changedAssets) == 0 && buildOptions.Verbosity == build.VERBOSE {
+						logutils.LogDarkYellow("Changed files are not within the asset directory '%s', skipping reload", assetDir)
 					}
+				} else if assetDirErr != nil {
+					logutils.LogRed("Reloading couldn't be triggered: Unable to retrieve the asset directory from the dev server: %s", assetDirErr.Error())
 				} else if len(dirsThatTriggerAReload) == 0 {
-					logutils.LogRed("Reloading couldn't be triggered: Please specify -assetdir or -reloaddirs")
+					logutils.LogRed("Reloading couldn't be triggered: The application is not serving assets from a directory. Please specify -assetdir or -reloaddirs")
 				}
 			}
 			if reload {
 				reload = false
-				_, err := http.Get(reloadURL)
+				// The changed files allow frameworks to update the frontend partially
+				query := ""
+				if len(reloadPaths) != 0 {
+					query = "?" + url.Values{"path": relativePaths(cwd, reloadPaths)}.Encode()
+				}
+				reloadPaths = map[string]struct{}{}
+				resp, err := http.Get(reloadURL + query)
+				if err != nil {
+					logutils.LogRed("Error during refresh: %s", err.Error())
+				} else {
+					resp.Body.Close()
+					logutils.LogJSON(logutils.LevelInfo, "Reloaded the frontend")
+				}
+			} else if len(changedAssets) != 0 {
+				query := url.Values{"path": changedAssets}
+				resp, err := http.Get(reloadAssetsURL + "?" + query.Encode())
 				if err != nil {
 					logutils.LogRed("Error during refresh: %s", err.Error())
+				} else {
+					resp.Body.Close()
+					logutils.LogJSON(logutils.LevelInfo, "Reloaded the changed assets: %s", strings.Join(changedAssets, ", "))
 				}
 			}
 			changedPaths = map[string]struct{}{}
@@ -538,7 +983,79 @@ func doWatcherLoop(cwd string, reloadDirs string, buildOptions *build.Options, d
 			quit = true
 		}
 	}
-	return debugBinaryProcess, nil
+	return nil
+}
+
+// notifyDevReload asks the running application to emit the wails:dev:reload event with the changed paths
+func notifyDevReload(devReloadURL string, paths []string) {
+	query := url.Values{"path": paths}
+	resp, err := http.Get(devReloadURL + "?" + query.Encode())
+	if err != nil {
+		logutils.LogDarkYellow("Unable to emit the wails:dev:reload event: %s", err.Error())
+		return
+	}
+	resp.Body.Close()
+	time.Sleep(devReloadEventDelay)
+}
+
+// relativePaths returns the paths relative to the root with forward slashes, sorted
+func relativePaths(root string, paths map[string]struct{}) []string {
+	result := make([]string, 0, len(paths))
+	for path := range paths {
+		if rel, err := filepath.Rel(root, path); err == nil {
+			path = rel
+		}
+		result = append(result, filepath.ToSlash(path))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// sortedPaths returns the paths of all the sets, sorted and without duplicates
+func sortedPaths(sets ...map[string]struct{}) []string {
+	var result []string
+	for _, set := range sets {
+		for path := range set {
+			result = append(result, path)
+		}
+	}
+	result = lo.Uniq(result)
+	sort.Strings(result)
+	return result
+}
+
+// fetchAssetDir retrieves the directory the running application serves its assets from.
+// An empty string without an error means the application doesn't serve assets from disk.
+func fetchAssetDir(assetDirURL string) (string, error) {
+	resp, err := http.Get(assetDirURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response from dev server: %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return conv.BytesToString(content), nil
+}
+
+// assetURLPaths returns the URL paths of the changed files that are located within assetDir
+func assetURLPaths(assetDir string, changedPaths map[string]struct{}) []string {
+	var result []string
+	for thePath := range changedPaths {
+		rel, err := filepath.Rel(assetDir, thePath)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		result = append(result, "/"+filepath.ToSlash(rel))
+	}
+	sort.Strings(result)
+	return result
 }
 
 func joinPath(url *url.URL, subPath string) string {
@@ -6,6 +6,7 @@ package dev
 import (
 	"os/exec"
 	"syscall"
+	"time"
 
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
 	"golang.org/x/sys/unix"
@@ -17,7 +18,9 @@ func setParentGID(cmd *exec.Cmd) {
 	}
 }
 
-func killProc(cmd *exec.Cmd, devCommand string) {
+// killProc sends SIGTERM to the process group of the command. If processes of the group are still running after
+// the timeout, the whole group is killed with SIGKILL
+func killProc(cmd *exec.Cmd, devCommand string, timeout time.Duration) {
 	if cmd == nil || cmd.Process == nil {
 		return
 	}
@@ -28,10 +31,29 @@ func killProc(cmd *exec.Cmd, devCommand string) {
 	// Credit: https://stackoverflow.com/a/29552044/14764450 (same page as the Windows solution above)
 	// Not tested on *nix
 	pgid, err := syscall.Getpgid(cmd.Process.Pid)
-	if err == nil {
-		err := syscall.Kill(-pgid, unix.SIGTERM) // note the minus sign
-		if err != nil {
-			logutils.LogRed("Error from '%s' when attempting to kill the process: %s", devCommand, err.Error())
+	if err != nil {
+		return
+	}
+	if err := syscall.Kill(-pgid, unix.SIGTERM); err != nil { // note the minus sign
+		logutils.LogRed("Error from '%s' when attempting to kill the process: %s", devCommand, err.Error())
+		return
+	}
+
+	// Node processes sometimes ignore SIGTERM and keep holding the port of the dev server
+	deadline := time.Now().Add(timeout)
+	for processGroupRunning(pgid) {
+		if time.Now().After(deadline) {
+			logutils.LogRed("DevWatcher '%s' didn't exit after %s, killing it", devCommand, timeout)
+			if err := syscall.Kill(-pgid, unix.SIGKILL); err != nil && err != unix.ESRCH {
+				logutils.LogRed("Error from '%s' when attempting to kill the process: %s", devCommand, err.Error())
+			}
+			return
 		}
+		time.Sleep(50 * time.Millisecond)
 	}
 }
+
+// processGroupRunning returns true as long as a process of the group hasn't exited
+func processGroupRunning(pgid int) bool {
+	return syscall.Kill(-pgid, 0) != unix.ESRCH
+}
@@ -0,0 +1,187 @@
+//go:build darwin || linux
+// +build darwin linux
+
+package dev
+
+import (
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/process"
+)
+
+func startTestProcess(t *testing.T, script string) (*exec.Cmd, chan error) {
+	cmd := exec.Command("sh", "-c", script)
+	setParentGID(cmd)
+	require.NoError(t, cmd.Start())
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+	// Give the shell time to install the trap
+	time.Sleep(200 * time.Millisecond)
+	return cmd, exited
+}
+
+func Test_killProcForceKillsAfterTimeout(t *testing.T) {
+	// The child ignores SIGTERM, like a lingering node process, and so does the sleep it starts
+	cmd, exited := startTestProcess(t, `trap "" TERM; sleep 30; sleep 30`)
+
+	timeout := 300 * time.Millisecond
+	start := time.Now()
+	killProc(cmd, "test", timeout)
+	require.GreaterOrEqual(t, time.Since(start), timeout)
+
+	select {
+	case err := <-exited:
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "killed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the process wasn't killed")
+	}
+	pgid := cmd.Process.Pid
+	require.Eventually(t, func() bool { return !processGroupRunning(pgid) }, 5*time.Second, 50*time.Millisecond)
+}
+
+func Test_killProcTerminatesBeforeTimeout(t *testing.T) {
+	cmd, exited := startTestProcess(t, `sleep 30`)
+
+	start := time.Now()
+	killProc(cmd, "test", 10*time.Second)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	select {
+	case err := <-exited:
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "terminated")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the process wasn't terminated")
+	}
+}
+
+func Test_handleSignals(t *testing.T) {
+	tests := []struct {
+		name     string
+		watching bool
+	}{
+		{"before the watcher loop", false},
+		{"watcher loop not exiting", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binary := filepath.Join(t.TempDir(), "app")
+			require.NoError(t, os.WriteFile(binary, []byte("binary"), 0o755))
+			app := process.NewProcess("sleep", "30")
+			require.NoError(t, app.Start(make(chan int, 1)))
+			t.Cleanup(func() { _ = app.Kill() })
+
+			var closed atomic.Bool
+			cleanup := &devCleanup{}
+			cleanup.setCloser(func() { closed.Store(true) })
+			cleanup.setApplication([]*process.Process{app}, binary)
+
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGTERM)
+			defer signal.Stop(signals)
+			quitChannel := make(chan os.Signal, 1)
+			var watching atomic.Bool
+			watching.Store(tt.watching)
+			exitCode := make(chan int, 1)
+			go handleSignals(signals, quitChannel, &watching, cleanup, 200*time.Millisecond, func(code int) { exitCode <- code })
+
+			require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+			select {
+			case code := <-exitCode:
+				require.Equal(t, 1, code)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the signal handler didn't exit")
+			}
+
+			require.True(t, closed.Load())
+			require.False(t, app.Running)
+			require.False(t, fs.FileExists(binary))
+			if tt.watching {
+				require.Equal(t, syscall.SIGTERM, <-quitChannel)
+			} else {
+				require.Empty(t, quitChannel)
+			}
+
+			// The cleanup only runs once, processes of a restart in progress are killed straight away
+			restarted := process.NewProcess("sleep", "30")
+			require.NoError(t, restarted.Start(make(chan int, 1)))
+			require.NoError(t, cleanup.run())
+			cleanup.setApplication([]*process.Process{restarted}, "")
+			require.False(t, restarted.Running)
+		})
+	}
+}
+
+func Test_frontendDevWatchersAcrossRebuilds(t *testing.T) {
+	// The command prints the URL of its dev server like Vite on every start
+	dir := t.TempDir()
+	script := "echo '  Local:   http://localhost:5173/'\nexec sleep 30\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "watcher.sh"), []byte(script), 0o755))
+	frontend := newFrontendDevWatchers(dir, []string{"sh watcher.sh"}, time.Second)
+	var closes atomic.Int32
+	cleanup := &devCleanup{}
+	cleanup.setCloser(func() {
+		closes.Add(1)
+		frontend.close()
+	})
+	require.NoError(t, frontend.start())
+	pids := frontend.pids()
+	require.Len(t, pids, 1)
+
+	// By default, the commands keep running across the rebuilds
+	for i := 0; i < 3; i++ {
+		restartFrontendDevWatchers(frontend, false, true)
+		cleanup.setApplication(nil, "")
+	}
+	require.Equal(t, pids, frontend.pids())
+	require.True(t, processGroupRunning(pids[0]))
+	require.Zero(t, closes.Load())
+
+	// -restartfrontendonrebuild restarts them
+	restartFrontendDevWatchers(frontend, true, false)
+	restarted := frontend.pids()
+	require.Len(t, restarted, 1)
+	require.NotEqual(t, pids, restarted)
+	require.Eventually(t, func() bool { return !processGroupRunning(pids[0]) }, 5*time.Second, 50*time.Millisecond)
+	require.Zero(t, closes.Load())
+
+	// The URLs printed by the restarted commands aren't read, they must not block the output
+	for i := 0; i < 3; i++ {
+		restartFrontendDevWatchers(frontend, true, false)
+	}
+	restarted = frontend.pids()
+	require.Eventually(t, func() bool { return len(frontend.scanner.ViteServerURLChan) == cap(frontend.scanner.ViteServerURLChan) }, 5*time.Second, 50*time.Millisecond)
+	written := make(chan struct{})
+	go func() {
+		_, _ = frontend.scanner.Write([]byte("  Local:   http://localhost:5174/\n"))
+		close(written)
+	}()
+	select {
+	case <-written:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the output of the DevWatcher commands is blocked")
+	}
+
+	require.NoError(t, cleanup.run())
+	require.NoError(t, cleanup.run())
+	require.Equal(t, int32(1), closes.Load())
+	require.Empty(t, frontend.pids())
+	require.Eventually(t, func() bool { return !processGroupRunning(restarted[0]) }, 5*time.Second, 50*time.Millisecond)
+
+	// Once closed, the commands aren't started again
+	require.NoError(t, frontend.restart())
+	require.Empty(t, frontend.pids())
+}
@@ -0,0 +1,214 @@
+package dev
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/commands/build"
+)
+
+func Test_assetURLPaths(t *testing.T) {
+	assetDir := filepath.Join("project", "frontend", "dist")
+	changedPaths := map[string]struct{}{
+		filepath.Join(assetDir, "style.css"):                {},
+		filepath.Join(assetDir, "images", "logo.png"):       {},
+		filepath.Join("project", "frontend", "src", "a.ts"): {},
+		filepath.Join("project", "frontend", "dist-other"):  {},
+	}
+
+	require.Equal(t, []string{"/images/logo.png", "/style.css"}, assetURLPaths(assetDir, changedPaths))
+	require.Empty(t, assetURLPaths(assetDir, map[string]struct{}{}))
+}
+
+func Test_relativePaths(t *testing.T) {
+	root := filepath.Join("project")
+	paths := map[string]struct{}{
+		filepath.Join(root, "frontend", "index.html"): {},
+		filepath.Join(root, "assets", "app.js"):       {},
+		filepath.Join("shared", "theme.css"):          {},
+	}
+	require.Equal(t, []string{"../shared/theme.css", "assets/app.js", "frontend/index.html"}, relativePaths(root, paths))
+}
+
+func Test_sortedPaths(t *testing.T) {
+	rebuildPaths := map[string]struct{}{"main.go": {}, "app.go": {}}
+	changedPaths := map[string]struct{}{"frontend/dist/style.css": {}, "app.go": {}}
+	require.Equal(t, []string{"app.go", "frontend/dist/style.css", "main.go"}, sortedPaths(rebuildPaths, changedPaths))
+	require.Empty(t, sortedPaths(map[string]struct{}{}))
+}
+
+func Test_formatBuildOptions(t *testing.T) {
+	options := &build.Options{
+		Mode:     build.Dev,
+		Platform: "darwin",
+		Arch:     "arm64",
+		Compiler: "go",
+		UserTags: []string{"a", "b"},
+		LDFlags:  "-X main.version=1",
+		TrimPath: true,
+	}
+	formatted := formatBuildOptions(options)
+	require.Contains(t, formatted, "Mode:           dev\n")
+	require.Contains(t, formatted, "Platform:       darwin/arm64\n")
+	require.Contains(t, formatted, "Compiler:       go\n")
+	require.Contains(t, formatted, "Tags:           a,b\n")
+	require.Contains(t, formatted, "LDFlags:        -X main.version=1\n")
+	require.Contains(t, formatted, "TrimPath:       true\n")
+}
+
+func Test_buildTimes(t *testing.T) {
+	var times buildTimes
+	for _, duration := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second} {
+		_, slow := times.add(duration)
+		require.False(t, slow)
+	}
+	average, slow := times.add(4 * time.Second)
+	require.Equal(t, 2*time.Second, average)
+	require.False(t, slow)
+
+	average, slow = times.add(6 * time.Second)
+	require.Equal(t, 2500*time.Millisecond, average)
+	require.True(t, slow)
+
+	// Only the last rebuilds are tracked
+	for i := 0; i < buildTimesWindow; i++ {
+		times.add(time.Second)
+	}
+	require.Equal(t, time.Second, times.average())
+	_, slow = times.add(3 * time.Second)
+	require.True(t, slow)
+
+	require.Equal(t, "1.8s", formatBuildDuration(1840*time.Millisecond))
+}
+
+func Test_goOffline(t *testing.T) {
+	t.Setenv("GOPROXY", "https://proxy.golang.org")
+	t.Setenv("GOFLAGS", "-trimpath")
+	require.NoError(t, goOffline())
+	require.Equal(t, "off", os.Getenv("GOPROXY"))
+	require.Equal(t, "-trimpath -mod=mod", os.Getenv("GOFLAGS"))
+
+	t.Setenv("GOFLAGS", "-mod=vendor")
+	require.NoError(t, goOffline())
+	require.Equal(t, "-mod=vendor", os.Getenv("GOFLAGS"))
+}
+
+func Test_envFileEnvironment(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), ".env")
+	content := `# Comment
+API_URL=http://localhost:8080 # local server
+export TOKEN='se"cret'
+MESSAGE="line 1\nline 2"
+EMPTY=
+WAILS_ENV_FILE_TEST_SET=from file
+`
+	require.NoError(t, os.WriteFile(envFile, []byte(content), 0o644))
+
+	variables, err := readEnvFile(envFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"API_URL":                 "http://localhost:8080",
+		"TOKEN":                   `se"cret`,
+		"MESSAGE":                 "line 1\nline 2",
+		"EMPTY":                   "",
+		"WAILS_ENV_FILE_TEST_SET": "from file",
+	}, variables)
+
+	t.Setenv("WAILS_ENV_FILE_TEST_SET", "from environment")
+	environment, err := envFileEnvironment(envFile)
+	require.NoError(t, err)
+	require.Contains(t, environment, "API_URL=http://localhost:8080")
+	require.Contains(t, environment, "WAILS_ENV_FILE_TEST_SET=from environment")
+	require.NotContains(t, environment, "WAILS_ENV_FILE_TEST_SET=from file")
+
+	require.NoError(t, os.WriteFile(envFile, []byte("NOT A VARIABLE\n"), 0o644))
+	_, err = envFileEnvironment(envFile)
+	require.ErrorContains(t, err, ".env:1")
+}
+
+func Test_checkCommand(t *testing.T) {
+	require.NoError(t, checkCommand("go version"))
+	require.Error(t, checkCommand("  "))
+	require.Error(t, checkCommand("wails-command-which-does-not-exist --flag"))
+}
+
+func Test_checkDevPlan(t *testing.T) {
+	projectDir := t.TempDir()
+	projectConfig := &project.Project{
+		Path:              projectDir,
+		FrontendDir:       "frontend",
+		DevWatcherCommand: "wails-command-which-does-not-exist",
+	}
+	f := &flags.Dev{
+		AssetDir:   filepath.Join(projectDir, "dist"),
+		ReloadDirs: filepath.Join(projectDir, "missing"),
+	}
+	problems := checkDevPlan(f, projectConfig, &build.Options{Compiler: "go"})
+	require.Len(t, problems, 4)
+
+	require.NoError(t, os.Mkdir(filepath.Join(projectDir, "frontend"), 0o755))
+	f.AssetDir = ""
+	f.ReloadDirs = ""
+	projectConfig.DevWatcherCommand = "go version"
+	require.Empty(t, checkDevPlan(f, projectConfig, &build.Options{Compiler: "go"}))
+}
+
+func Test_doWatcherLoopReloadsAfterFailedRebuild(t *testing.T) {
+	projectDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	assetDir := filepath.Join(projectDir, "frontend", "dist")
+	require.NoError(t, os.MkdirAll(assetDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "wails.json"), []byte(`{"name": "test"}`), 0o644))
+
+	reloadedAssets := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wails/assetdir":
+			_, _ = w.Write([]byte(assetDir))
+		case "/wails/reloadassets":
+			reloadedAssets <- r.URL.Query().Get("path")
+		}
+	}))
+	defer server.Close()
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(projectDir))
+	defer func() { require.NoError(t, os.Chdir(cwd)) }()
+
+	f := (*flags.Dev)(nil).Default()
+	f.DevServer = server.Listener.Addr().String()
+	// The rebuild fails before the asset change is reloaded
+	f.Debounce = 500
+	f.GoDebounce = 50
+	require.NoError(t, f.Process())
+	buildOptions := f.GenerateBuildOptions()
+	buildOptions.OutputType = "unsupported"
+
+	quitChannel := make(chan os.Signal, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- doWatcherLoop(projectDir, "", buildOptions, nil, f, make(chan int), quitChannel, f.ControlURL(), false, &devCleanup{}, nil)
+	}()
+	// Give the watcher time to watch the project directories
+	time.Sleep(200 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(assetDir, "style.css"), []byte("body {}\n"), 0o644))
+	select {
+	case path := <-reloadedAssets:
+		require.Equal(t, "/style.css", path)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the changed asset hasn't been reloaded after the failed rebuild")
+	}
+
+	quitChannel <- os.Interrupt
+	require.NoError(t, <-done)
+}
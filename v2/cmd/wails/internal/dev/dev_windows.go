@@ -7,13 +7,15 @@ import (
 	"bytes"
 	"os/exec"
 	"strconv"
+	"time"
 
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
 )
 
 func setParentGID(_ *exec.Cmd) {}
 
-func killProc(cmd *exec.Cmd, devCommand string) {
+// killProc kills the process tree of the command. The timeout isn't used, as the tree is always forcibly terminated
+func killProc(cmd *exec.Cmd, devCommand string, _ time.Duration) {
 	// Credit: https://stackoverflow.com/a/44551450
 	// For whatever reason, killing an npm script on windows just doesn't exit properly with cancel
 	if cmd != nil && cmd.Process != nil {
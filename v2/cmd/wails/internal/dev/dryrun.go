@@ -0,0 +1,128 @@
+package dev
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/cmd/wails/flags"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/clilogger"
+	"github.com/wailsapp/wails/v2/pkg/commands/build"
+)
+
+// dryRun validates the configuration of `wails dev` and prints what would be run, without building or running
+// the application. It returns an error listing all problems which have been found
+func dryRun(f *flags.Dev, projectConfig *project.Project, buildOptions *build.Options, logger *clilogger.CLILogger) error {
+	logger.Println("Dry run plan:")
+	logger.Println("  Project directory:   %s", projectConfig.Path)
+	logger.Println("  Frontend directory:  %s", projectConfig.GetFrontendDir())
+	if f.AssetDir != "" {
+		logger.Println("  Asset directory:     %s", f.AssetDir)
+	} else {
+		logger.Println("  Asset directory:     embedded assets")
+	}
+	switch {
+	case f.SkipFrontend:
+		logger.Println("  Frontend build:      skipped")
+	case projectConfig.GetDevBuildCommand() == "":
+		logger.Println("  Frontend build:      none")
+	default:
+		logger.Println("  Frontend build:      %s", projectConfig.GetDevBuildCommand())
+	}
+	for _, command := range projectConfig.GetDevWatcherCommands() {
+		logger.Println("  DevWatcher command:  %s", command)
+	}
+	if f.FrontendDevServerURL != "" {
+		logger.Println("  Frontend DevServer:  %s", f.FrontendDevServerURL)
+	}
+	logger.Println("  DevServer URL:       %s", f.DevServerURL())
+	logger.Println("  Rebuild extensions:  %s", f.Extensions)
+	if f.ReloadExtensions != "" {
+		logger.Println("  Reload extensions:   %s", f.ReloadExtensions)
+	}
+	if f.WatchActions != "" {
+		logger.Println("  Watch actions:       %s", f.WatchActions)
+	}
+	if f.ReloadDirs != "" {
+		logger.Println("  Reload directories:  %s", f.ReloadDirs)
+	}
+	for _, dir := range f.WatchDirectories() {
+		logger.Println("  Watch directory:     %s", dir)
+	}
+	if f.SafeMode {
+		logger.Println("  Safe mode:           enabled")
+	}
+	if f.EnvFilePath() != "" {
+		logger.Println("  Env file:            %s", f.EnvFilePath())
+	}
+	logger.Println("%s", formatBuildOptions(buildOptions))
+
+	problems := checkDevPlan(f, projectConfig, buildOptions)
+	if len(problems) != 0 {
+		for _, problem := range problems {
+			logutils.LogRed("  - %s", problem)
+		}
+		return fmt.Errorf("dry run found %d problem(s)", len(problems))
+	}
+
+	logutils.LogGreen("Dry run succeeded, the application would be built and started with this configuration")
+	return nil
+}
+
+// checkDevPlan returns the problems which would stop `wails dev` from building and running the application
+func checkDevPlan(f *flags.Dev, projectConfig *project.Project, buildOptions *build.Options) []string {
+	var problems []string
+
+	if f.AssetDir != "" && !fs.DirExists(f.AssetDir) {
+		problems = append(problems, fmt.Sprintf("the asset directory '%s' does not exist", f.AssetDir))
+	}
+	if !fs.DirExists(projectConfig.GetFrontendDir()) {
+		problems = append(problems, fmt.Sprintf("the frontend directory '%s' does not exist", projectConfig.GetFrontendDir()))
+	}
+	for _, dir := range strings.Split(f.ReloadDirs, ",") {
+		if dir == "" {
+			continue
+		}
+		if thePath, err := filepath.Abs(dir); err != nil || !fs.DirExists(thePath) {
+			problems = append(problems, fmt.Sprintf("the reload directory '%s' does not exist", dir))
+		}
+	}
+
+	if f.EnvFilePath() != "" {
+		if _, err := readEnvFile(f.EnvFilePath()); err != nil {
+			problems = append(problems, fmt.Sprintf("the env file can't be loaded: %s", err))
+		}
+	}
+
+	commands := projectConfig.GetDevWatcherCommands()
+	for _, command := range commands {
+		if err := checkCommand(command); err != nil {
+			problems = append(problems, fmt.Sprintf("the DevWatcher command '%s' can't be run: %s", command, err))
+		}
+	}
+	if len(commands) == 0 && projectConfig.IsFrontendDevServerURLAutoDiscovery() {
+		problems = append(problems, "frontend:dev:serverUrl is set to 'auto' without a frontend:dev:watcher command")
+	}
+
+	if _, err := exec.LookPath(buildOptions.Compiler); err != nil {
+		problems = append(problems, fmt.Sprintf("the compiler '%s' can't be found: %s", buildOptions.Compiler, err))
+	}
+
+	return problems
+}
+
+// checkCommand checks that the command is not empty and that its executable can be found
+func checkCommand(command string) error {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return fmt.Errorf("the command is empty")
+	}
+	if _, err := exec.LookPath(fields[0]); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package dev
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// readEnvFile parses the variables of a .env file. Every line is of the form KEY=VALUE, optionally prefixed with
+// `export`. Empty lines and lines starting with # are ignored. Values may be quoted with single or double quotes,
+// double quoted values support the escapes \n, \t, \" and \\. Unquoted values end at a " #" comment
+func readEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	result := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, lineNumber)
+		}
+		value, err = parseEnvValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+		result[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseEnvValue(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	switch quote := value[0]; quote {
+	case '\'', '"':
+		end := strings.LastIndexByte(value, quote)
+		if end == 0 {
+			return "", fmt.Errorf("missing closing quote")
+		}
+		if rest := strings.TrimSpace(value[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+			return "", fmt.Errorf("unexpected '%s' after the quoted value", rest)
+		}
+		value = value[1:end]
+		if quote == '"' {
+			value = strings.NewReplacer(`\n`, "\n", `\t`, "\t", `\"`, `"`, `\\`, `\`).Replace(value)
+		}
+		return value, nil
+	}
+	if comment := strings.Index(value, " #"); comment != -1 {
+		value = strings.TrimSpace(value[:comment])
+	}
+	return value, nil
+}
+
+// envFileEnvironment returns the environment of the current process, extended by the variables of the env file
+// which aren't set in it. Variables of the current process always take precedence
+func envFileEnvironment(path string) ([]string, error) {
+	environment := os.Environ()
+	if path == "" {
+		return environment, nil
+	}
+	variables, err := readEnvFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range variables {
+		if _, exists := os.LookupEnv(key); !exists {
+			environment = append(environment, key+"="+value)
+		}
+	}
+	return environment, nil
+}
@@ -0,0 +1,145 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// directoryPoller watches the directories with -pollwatcher, EG: on network filesystems which don't report changes,
+// and those which can't be watched with fsnotify because the limit of the OS for watches or open files has been
+// reached. It compares the modification times of the files in the directories,
+// not recursively, and sends the changes as fsnotify events
+type directoryPoller struct {
+	Events chan fsnotify.Event
+
+	lock     sync.Mutex
+	dirs     map[string]struct{}
+	modTimes map[string]time.Time
+	done     chan struct{}
+}
+
+func newDirectoryPoller(dirs []string, interval time.Duration) *directoryPoller {
+	result := &directoryPoller{
+		Events:   make(chan fsnotify.Event, 100),
+		dirs:     map[string]struct{}{},
+		modTimes: map[string]time.Time{},
+		done:     make(chan struct{}),
+	}
+	for _, dir := range dirs {
+		result.Add(dir)
+	}
+	go result.run(interval)
+	return result
+}
+
+// Add polls the directory as well. Files which exist already aren't reported as created
+func (p *directoryPoller) Add(dir string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.dirs[dir] = struct{}{}
+	for path, modTime := range readModTimes(dir) {
+		p.modTimes[path] = modTime
+	}
+}
+
+// Close stops polling
+func (p *directoryPoller) Close() {
+	close(p.done)
+}
+
+func (p *directoryPoller) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer close(p.Events)
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			for _, event := range p.poll() {
+				select {
+				case p.Events <- event:
+				case <-p.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// poll returns the changes since the previous poll
+func (p *directoryPoller) poll() []fsnotify.Event {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	current := map[string]time.Time{}
+	for dir := range p.dirs {
+		for path, modTime := range readModTimes(dir) {
+			current[path] = modTime
+		}
+	}
+
+	var events []fsnotify.Event
+	for path, modTime := range current {
+		previous, exists := p.modTimes[path]
+		switch {
+		case !exists:
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Create})
+		case !modTime.Equal(previous):
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Write})
+		}
+	}
+	for path := range p.modTimes {
+		if _, exists := current[path]; !exists {
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Remove})
+		}
+	}
+	p.modTimes = current
+	return events
+}
+
+// mergeEvents returns a channel with the events of both channels, which is closed once both of them are closed
+func mergeEvents(a <-chan fsnotify.Event, b <-chan fsnotify.Event) <-chan fsnotify.Event {
+	result := make(chan fsnotify.Event)
+	go func() {
+		defer close(result)
+		for a != nil || b != nil {
+			select {
+			case event, ok := <-a:
+				if !ok {
+					a = nil
+					continue
+				}
+				result <- event
+			case event, ok := <-b:
+				if !ok {
+					b = nil
+					continue
+				}
+				result <- event
+			}
+		}
+	}()
+	return result
+}
+
+// readModTimes returns the modification times of the entries of the directory
+func readModTimes(dir string) map[string]time.Time {
+	result := map[string]time.Time{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return result
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		result[filepath.Join(dir, entry.Name())] = info.ModTime()
+	}
+	return result
+}
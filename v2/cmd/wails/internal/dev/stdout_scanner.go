@@ -5,73 +5,124 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/acarl005/stripansi"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/logutils"
 	"golang.org/x/mod/semver"
 )
 
+// devServerInfo is what the stdoutScanner detected about the dev server of the frontend DevWatcher commands
+type devServerInfo struct {
+	Framework string
+	Version   string
+	URL       string
+}
+
+// String returns the diagnostic which is logged at startup, EG: "Detected Vite v5.0.0 at http://localhost:5173"
+func (i devServerInfo) String() string {
+	if i.Framework == "" {
+		return "The frontend DevWatcher command produced no recognizable dev server output. Please check it starts a dev server"
+	}
+	result := "Detected " + i.Framework
+	if i.Version != "" {
+		result += " " + i.Version
+	}
+	if i.URL != "" {
+		result += " at " + i.URL
+	}
+	return result
+}
+
 // stdoutScanner acts as a stdout target that will scan the incoming
-// data to find out the vite server url
+// data to find out the vite server url and which dev server is running
 type stdoutScanner struct {
-	ViteServerURLChan  chan string
-	ViteServerVersionC chan string
-	versionDetected    bool
+	ViteServerURLChan chan string
+	// FrameworkDetectedC receives the framework once it has been detected
+	FrameworkDetectedC chan string
+	devServer          devServerInfo
+
+	// The output of several commands may be written concurrently
+	lock sync.Mutex
 }
 
 // NewStdoutScanner creates a new stdoutScanner
 func NewStdoutScanner() *stdoutScanner {
 	return &stdoutScanner{
 		ViteServerURLChan:  make(chan string, 2),
-		ViteServerVersionC: make(chan string, 2),
+		FrameworkDetectedC: make(chan string, 1),
 	}
 }
 
+// DevServer returns what has been detected about the dev server so far
+func (s *stdoutScanner) DevServer() devServerInfo {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.devServer
+}
+
 // Write bytes to the scanner. Will copy the bytes to stdout
 func (s *stdoutScanner) Write(data []byte) (n int, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	input := stripansi.Strip(string(data))
-	if !s.versionDetected {
-		v, err := detectViteVersion(input)
-		if v != "" || err != nil {
-			if err != nil {
-				logutils.LogRed("ViteStdoutScanner: %s", err)
-				v = "v0.0.0"
-			}
-			s.ViteServerVersionC <- v
-			s.versionDetected = true
+	sc := bufio.NewScanner(strings.NewReader(input))
+	for sc.Scan() {
+		line := sc.Text()
+		if s.devServer.Framework == "" {
+			s.detectFramework(line)
+		}
+		if s.devServer.URL == "" {
+			s.devServer.URL = detectDevServerURL(line)
 		}
-	}
 
-	match := strings.Index(input, "Local:")
-	if match != -1 {
-		sc := bufio.NewScanner(strings.NewReader(input))
-		for sc.Scan() {
-			line := sc.Text()
-			index := strings.Index(line, "Local:")
-			if index == -1 || len(line) < 7 {
-				continue
-			}
-			viteServerURL := strings.TrimSpace(line[index+6:])
-			logutils.LogGreen("Vite Server URL: %s", viteServerURL)
-			_, err := url.Parse(viteServerURL)
-			if err != nil {
-				logutils.LogRed(err.Error())
-			} else {
-				s.ViteServerURLChan <- viteServerURL
+		index := strings.Index(line, "Local:")
+		if index == -1 || len(line) < 7 {
+			continue
+		}
+		viteServerURL := strings.TrimSpace(line[index+6:])
+		logutils.LogGreen("Vite Server URL: %s", viteServerURL)
+		_, err := url.Parse(viteServerURL)
+		if err != nil {
+			logutils.LogRed(err.Error())
+		} else {
+			// Only the first URL is read at startup. Restarted DevWatcher commands print it again, which must not
+			// block their output
+			select {
+			case s.ViteServerURLChan <- viteServerURL:
+			default:
 			}
 		}
 	}
 	return os.Stdout.Write(data)
 }
 
+func (s *stdoutScanner) detectFramework(line string) {
+	v, err := detectViteVersion(line)
+	if v != "" || err != nil {
+		if err != nil {
+			logutils.LogRed("ViteStdoutScanner: %s", err)
+			v = "v0.0.0"
+		}
+		s.devServer.Framework, s.devServer.Version = "Vite", v
+	} else {
+		s.devServer.Framework, s.devServer.Version = detectFramework(line)
+	}
+	if s.devServer.Framework != "" {
+		s.FrameworkDetectedC <- s.devServer.Framework
+	}
+}
+
 func detectViteVersion(line string) (string, error) {
-	s := strings.Split(strings.TrimSpace(line), " ")
-	if strings.ToLower(s[0]) != "vite" {
+	s := strings.Fields(line)
+	if len(s) == 0 || strings.ToLower(s[0]) != "vite" {
 		return "", nil
 	}
 
-	if len(line) < 2 {
+	if len(s) < 2 {
 		return "", fmt.Errorf("unable to parse vite version")
 	}
 
@@ -82,3 +133,43 @@ func detectViteVersion(line string) (string, error) {
 
 	return v, nil
 }
+
+// frameworkPatterns detect the dev servers other than Vite by their output. The version is the first submatch, if any
+var frameworkPatterns = []struct {
+	framework string
+	pattern   *regexp.Regexp
+}{
+	{"Next.js", regexp.MustCompile(`^\W*Next\.js\s+v?(\d+\.\d+\.\d+)`)},
+	{"webpack", regexp.MustCompile(`^webpack\s+v?(\d+\.\d+\.\d+)\s+compiled`)},
+	{"webpack", regexp.MustCompile(`\[webpack-dev-server\]`)},
+	{"Angular", regexp.MustCompile(`Angular Live Development Server is listening`)},
+	{"Parcel", regexp.MustCompile(`^Server running at `)},
+}
+
+// detectFramework returns the framework and version of the dev server which produced the line, if any
+func detectFramework(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	for _, framework := range frameworkPatterns {
+		match := framework.pattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		version := ""
+		if len(match) > 1 {
+			version = "v" + match[1]
+		}
+		return framework.framework, version
+	}
+	return "", ""
+}
+
+var devServerURLPattern = regexp.MustCompile(`(?:Local:|Loopback:|Server running at|open your browser on)\s+(https?://\S+)`)
+
+// detectDevServerURL returns the local URL of the dev server in the line, if any
+func detectDevServerURL(line string) string {
+	match := devServerURLPattern.FindStringSubmatch(line)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimRight(match[1], ",")
+}
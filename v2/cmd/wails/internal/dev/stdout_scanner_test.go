@@ -0,0 +1,49 @@
+package dev
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_detectFramework(t *testing.T) {
+	tests := []struct {
+		line      string
+		framework string
+		version   string
+	}{
+		{"  ▲ Next.js 14.0.4", "Next.js", "v14.0.4"},
+		{"webpack 5.88.2 compiled successfully in 812 ms", "webpack", "v5.88.2"},
+		{"<i> [webpack-dev-server] Project is running at:", "webpack", ""},
+		{"** Angular Live Development Server is listening on localhost:4200, open your browser on http://localhost:4200/ **", "Angular", ""},
+		{"Server running at http://localhost:1234", "Parcel", ""},
+		{"> my-app@0.0.0 dev", "", ""},
+	}
+	for _, tt := range tests {
+		framework, version := detectFramework(tt.line)
+		require.Equal(t, tt.framework, framework, tt.line)
+		require.Equal(t, tt.version, version, tt.line)
+	}
+}
+
+func Test_detectDevServerURL(t *testing.T) {
+	require.Equal(t, "http://localhost:5173/", detectDevServerURL("  ➜  Local:   http://localhost:5173/"))
+	require.Equal(t, "http://localhost:8080/", detectDevServerURL("<i> [webpack-dev-server] Loopback: http://localhost:8080/, http://[::1]:8080/"))
+	require.Equal(t, "http://localhost:4200/", detectDevServerURL("** Angular Live Development Server is listening on localhost:4200, open your browser on http://localhost:4200/ **"))
+	require.Equal(t, "", detectDevServerURL("  ➜  Network: use --host to expose"))
+}
+
+func Test_stdoutScanner(t *testing.T) {
+	scanner := NewStdoutScanner()
+	_, err := scanner.Write([]byte("\n  \x1b[32mVITE\x1b[39m v5.0.0  ready in 300 ms\n\n  ➜  Local:   http://localhost:5173/\n"))
+	require.NoError(t, err)
+	require.Equal(t, "Vite", <-scanner.FrameworkDetectedC)
+	require.Equal(t, "http://localhost:5173/", <-scanner.ViteServerURLChan)
+	require.Equal(t, devServerInfo{Framework: "Vite", Version: "v5.0.0", URL: "http://localhost:5173/"}, scanner.DevServer())
+	require.Equal(t, "Detected Vite v5.0.0 at http://localhost:5173/", scanner.DevServer().String())
+
+	_, err = detectViteVersion("vite")
+	require.Error(t, err)
+
+	require.Contains(t, devServerInfo{}.String(), "produced no recognizable dev server output")
+}
@@ -2,9 +2,12 @@ package dev
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	"github.com/wailsapp/wails/v2/internal/fs"
 
@@ -17,39 +20,157 @@ type Watcher interface {
 	Add(name string) error
 }
 
-// initialiseWatcher creates the project directory watcher that will trigger recompile
-func initialiseWatcher(cwd, reloadDirs string) (*fsnotify.Watcher, error) {
-	// Ignore dot files, node_modules and build directories by default
-	ignoreDirs := getIgnoreDirs(cwd)
+// initialiseWatcher creates the project directory watcher that will trigger recompile. It watches the
+// watchedDirectories and returns the number of directories skipped because of the .gitignore files
+func initialiseWatcher(cwd, reloadDirs string, watchDirs []string, maxDepth int, watchAll bool) (*fsnotify.Watcher, int, error) {
+	dirsToWatch, skipped, err := watchedDirectories(cwd, reloadDirs, watchDirs, maxDepth, watchAll)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, dir := range dirsToWatch {
+		err := watcher.Add(dir)
+		if errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE) {
+			return watcher, skipped, &watchLimitError{err: err, added: i, remaining: dirsToWatch[i:]}
+		}
+		if err != nil {
+			_ = watcher.Close()
+			return nil, 0, err
+		}
+	}
+	return watcher, skipped, nil
+}
+
+// watchedDirectories returns the directories to watch. The watchDirs are absolute paths, which may be outside of the
+// project. Subdirectories of the reloadDirs and watchDirs more than maxDepth levels deep aren't watched, 0 means
+// unlimited. Directories matching the .gitignore files aren't watched either, unless watchAll is set. It returns the
+// number of directories skipped because of that
+func watchedDirectories(cwd, reloadDirs string, watchDirs []string, maxDepth int, watchAll bool) ([]string, int, error) {
+	ignoreDirs := watchIgnoreDirs(cwd, watchAll)
 
 	// Get all subdirectories
 	dirs, err := fs.GetSubdirectories(cwd)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	customDirs := dirs.AsSlice()
 	seperatedDirs := strings.Split(reloadDirs, ",")
 	for _, dir := range seperatedDirs {
-		customSub, err := fs.GetSubdirectories(filepath.Join(cwd, dir))
+		root := filepath.Join(cwd, dir)
+		if filepath.IsAbs(dir) {
+			root = dir
+		}
+		customSub, err := fs.GetSubdirectories(root)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
+		}
+		for _, sub := range customSub.AsSlice() {
+			if dir == "" || !exceedsDepth(root, sub, maxDepth) {
+				customDirs = append(customDirs, sub)
+			}
 		}
-		customDirs = append(customDirs, customSub.AsSlice()...)
 	}
 
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return nil, err
+	dirsToWatch := processDirectories(customDirs, ignoreDirs)
+	skipped := len(lo.Uniq(customDirs)) - len(lo.Uniq(dirsToWatch))
+	for _, root := range watchDirs {
+		// The watch directories have got their own .gitignore
+		subDirs, err := fs.GetSubdirectories(root)
+		if err != nil {
+			return nil, 0, err
+		}
+		processed := processDirectories(subDirs.AsSlice(), watchIgnoreDirs(root, watchAll))
+		skipped += subDirs.Length() - len(processed)
+		for _, sub := range processed {
+			if !exceedsDepth(root, sub, maxDepth) {
+				dirsToWatch = append(dirsToWatch, sub)
+			}
+		}
+	}
+	return dirsToWatch, skipped, nil
+}
+
+// watchLimitError is returned by initialiseWatcher together with the watcher if the limit of the OS for watches or
+// open files has been reached. The watcher watches the directories which have been added before
+type watchLimitError struct {
+	err       error
+	added     int
+	remaining []string
+}
+
+func (e *watchLimitError) Error() string {
+	return watchError(e.err).Error()
+}
+
+func (e *watchLimitError) Unwrap() error {
+	return e.err
+}
+
+// newDirectoryIgnorer returns a function which reports whether a new directory shouldn't be watched. The directories
+// of the watchDirs are matched against their own .gitignore, the others against the one of the project
+func newDirectoryIgnorer(cwd string, watchDirs []string, watchAll bool) func(dir string) bool {
+	projectIgnorer := gitignore.CompileIgnoreLines(watchIgnoreDirs(cwd, watchAll)...)
+	watchDirIgnorers := lo.Map(watchDirs, func(root string, _ int) *gitignore.GitIgnore {
+		return gitignore.CompileIgnoreLines(watchIgnoreDirs(root, watchAll)...)
+	})
+	return func(dir string) bool {
+		for i, root := range watchDirs {
+			if isInDirectory(dir, []string{root}) {
+				return watchDirIgnorers[i].MatchesPath(dir)
+			}
+		}
+		return projectIgnorer.MatchesPath(dir)
+	}
+}
+
+// watchIgnoreDirs returns the patterns of the directories below root which aren't watched: dot files, node_modules,
+// the build directory and the entries of the .gitignore. With watchAll only .git and node_modules are skipped
+func watchIgnoreDirs(root string, watchAll bool) []string {
+	if watchAll {
+		return []string{".git", "node_modules"}
 	}
+	return getIgnoreDirs(root)
+}
 
-	for _, dir := range processDirectories(customDirs, ignoreDirs) {
-		err := watcher.Add(dir)
-		if err != nil {
-			return nil, err
+// exceedsDepth returns true if dir is more than maxDepth levels below root, 0 means unlimited
+func exceedsDepth(root, dir string, maxDepth int) bool {
+	if maxDepth <= 0 {
+		return false
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." || !isInDirectory(dir, []string{root}) {
+		return false
+	}
+	return len(strings.Split(rel, string(filepath.Separator))) > maxDepth
+}
+
+// isInDirectory returns true if path is one of the dirs or below one of them
+func isInDirectory(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
 		}
 	}
-	return watcher, nil
+	return false
+}
+
+// watchError adds guidance to the error if the limit of the OS for watches or open files has been hit
+func watchError(err error) error {
+	switch {
+	case errors.Is(err, syscall.ENOSPC):
+		return fmt.Errorf("%w: the limit of inotify watches has been reached. Raise it with 'sudo sysctl fs.inotify.max_user_watches=524288' "+
+			"(add it to /etc/sysctl.conf to make it permanent) or watch fewer directories with -reloaddirsmaxdepth", err)
+	case errors.Is(err, syscall.EMFILE):
+		return fmt.Errorf("%w: the limit of open files has been reached. Raise it with 'ulimit -n' or watch fewer directories with -reloaddirsmaxdepth", err)
+	}
+	return err
 }
 
 func getIgnoreDirs(cwd string) []string {
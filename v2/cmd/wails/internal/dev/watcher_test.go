@@ -1,12 +1,17 @@
 package dev
 
 import (
+	"errors"
+	"fmt"
 	"github.com/samber/lo"
 	"os"
 	"path/filepath"
 	"reflect"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wailsapp/wails/v2/internal/fs"
@@ -111,3 +116,129 @@ func Test_GetIgnoreDirs(t *testing.T) {
 		})
 	}
 }
+
+func Test_exceedsDepth(t *testing.T) {
+	root := filepath.Join("project", "assets")
+	require.False(t, exceedsDepth(root, root, 1))
+	require.False(t, exceedsDepth(root, filepath.Join(root, "a"), 1))
+	require.True(t, exceedsDepth(root, filepath.Join(root, "a", "b"), 1))
+	require.False(t, exceedsDepth(root, filepath.Join(root, "a", "b", "c"), 0))
+	require.False(t, exceedsDepth(root, filepath.Join("project", "frontend", "a", "b"), 1))
+}
+
+func Test_isInDirectory(t *testing.T) {
+	dirs := []string{filepath.Join("shared", "ui"), filepath.Join("shared", "api")}
+	require.True(t, isInDirectory(filepath.Join("shared", "ui"), dirs))
+	require.True(t, isInDirectory(filepath.Join("shared", "api", "client.go"), dirs))
+	require.False(t, isInDirectory(filepath.Join("shared", "uikit", "button.go"), dirs))
+	require.True(t, isInDirectory(filepath.Join("..shared", "main.go"), []string{"."}))
+	require.False(t, isInDirectory("main.go", dirs))
+}
+
+func Test_initialiseWatcherMaxDepth(t *testing.T) {
+	dir := t.TempDir()
+	cwd := filepath.Join(dir, "project")
+	shared := filepath.Join(dir, "shared")
+	require.NoError(t, os.MkdirAll(filepath.Join(cwd, "frontend"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(shared, "a", "b", "c"), 0o755))
+
+	watcher, _, err := initialiseWatcher(cwd, "../shared", nil, 1, false)
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	watched := watcher.WatchList()
+	require.Contains(t, watched, filepath.Join(cwd, "frontend"))
+	require.Contains(t, watched, shared)
+	require.Contains(t, watched, filepath.Join(shared, "a"))
+	require.NotContains(t, watched, filepath.Join(shared, "a", "b"))
+}
+
+func Test_initialiseWatcherWatchDirs(t *testing.T) {
+	dir := t.TempDir()
+	cwd := filepath.Join(dir, "project")
+	shared := filepath.Join(dir, "shared")
+	require.NoError(t, os.MkdirAll(cwd, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(shared, "api", "generated"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(shared, "node_modules", "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(shared, ".gitignore"), []byte("generated\n"), 0o644))
+
+	watcher, skipped, err := initialiseWatcher(cwd, "", []string{shared}, 0, false)
+	require.NoError(t, err)
+	defer watcher.Close()
+	require.Equal(t, 3, skipped)
+
+	watched := watcher.WatchList()
+	require.Contains(t, watched, cwd)
+	require.Contains(t, watched, shared)
+	require.Contains(t, watched, filepath.Join(shared, "api"))
+	require.NotContains(t, watched, filepath.Join(shared, "api", "generated"))
+	require.NotContains(t, watched, filepath.Join(shared, "node_modules"))
+}
+
+func Test_initialiseWatcherWatchAll(t *testing.T) {
+	cwd := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(cwd, ".git", "objects"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(cwd, "dist"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(cwd, ".cache"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(cwd, ".gitignore"), []byte("dist\n"), 0o644))
+
+	watcher, skipped, err := initialiseWatcher(cwd, "", nil, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 4, skipped)
+	require.Equal(t, []string{cwd}, watcher.WatchList())
+	require.NoError(t, watcher.Close())
+
+	watcher, skipped, err = initialiseWatcher(cwd, "", nil, 0, true)
+	require.NoError(t, err)
+	defer watcher.Close()
+	require.Equal(t, 2, skipped)
+	require.ElementsMatch(t, []string{cwd, filepath.Join(cwd, "dist"), filepath.Join(cwd, ".cache")}, watcher.WatchList())
+
+	isIgnoredDir := newDirectoryIgnorer(cwd, nil, false)
+	require.True(t, isIgnoredDir(filepath.Join(cwd, "dist", "assets")))
+	require.False(t, isIgnoredDir(filepath.Join(cwd, "frontend")))
+}
+
+func Test_directoryPoller(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.go")
+	require.NoError(t, os.WriteFile(existing, []byte("package main"), 0o644))
+
+	poller := newDirectoryPoller([]string{dir}, time.Hour)
+	defer poller.Close()
+	require.Empty(t, poller.poll())
+
+	created := filepath.Join(dir, "created.go")
+	require.NoError(t, os.WriteFile(created, []byte("package main"), 0o644))
+	require.NoError(t, os.Chtimes(existing, time.Now(), time.Now().Add(time.Minute)))
+	require.ElementsMatch(t, []fsnotify.Event{
+		{Name: created, Op: fsnotify.Create},
+		{Name: existing, Op: fsnotify.Write},
+	}, poller.poll())
+
+	require.NoError(t, os.Remove(created))
+	require.Equal(t, []fsnotify.Event{{Name: created, Op: fsnotify.Remove}}, poller.poll())
+}
+
+func Test_mergeEvents(t *testing.T) {
+	a := make(chan fsnotify.Event, 1)
+	b := make(chan fsnotify.Event, 1)
+	merged := mergeEvents(a, b)
+	a <- fsnotify.Event{Name: "a"}
+	require.Equal(t, "a", (<-merged).Name)
+	close(a)
+	b <- fsnotify.Event{Name: "b"}
+	require.Equal(t, "b", (<-merged).Name)
+	close(b)
+	_, ok := <-merged
+	require.False(t, ok)
+}
+
+func Test_watchError(t *testing.T) {
+	err := watchError(fmt.Errorf("add: %w", syscall.ENOSPC))
+	require.ErrorIs(t, err, syscall.ENOSPC)
+	require.Contains(t, err.Error(), "fs.inotify.max_user_watches")
+
+	other := errors.New("other")
+	require.Equal(t, other, watchError(other))
+}
@@ -1,16 +1,103 @@
 package logutils
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/colour"
 )
 
+// The levels of the JSON messages
+const (
+	LevelInfo    = "info"
+	LevelWarning = "warning"
+	LevelError   = "error"
+)
+
+var (
+	// jsonOutput receives the messages as JSON lines if set, see EnableJSON
+	jsonOutput io.Writer
+	jsonLock   sync.Mutex
+)
+
+// jsonMessage is a message written by EnableJSON
+type jsonMessage struct {
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+	// DurationMS is the duration in milliseconds of messages logged with LogDuration
+	DurationMS int64 `json:"durationMs,omitempty"`
+}
+
+// EnableJSON writes the messages to output as JSON lines with their level, message and timestamp, instead of the
+// coloured text. LogGreen messages have got the level info, LogDarkYellow ones warning and LogRed ones error
+func EnableJSON(output io.Writer) {
+	jsonLock.Lock()
+	defer jsonLock.Unlock()
+	jsonOutput = output
+}
+
+// logJSON writes the message if JSON output has been enabled and returns whether it has been
+func writeJSON(level string, text string) bool {
+	return writeJSONMessage(jsonMessage{Level: level, Message: text})
+}
+
+func writeJSONMessage(message jsonMessage) bool {
+	jsonLock.Lock()
+	defer jsonLock.Unlock()
+	if jsonOutput == nil {
+		return false
+	}
+	message.Message = strings.TrimSpace(message.Message)
+	message.Timestamp = time.Now().Format(time.RFC3339Nano)
+	data, err := json.Marshal(message)
+	if err == nil {
+		_, _ = jsonOutput.Write(append(data, '\n'))
+	}
+	return true
+}
+
+// LogJSON writes the message with the level if JSON output has been enabled and does nothing otherwise. It is meant
+// for messages which the coloured output doesn't need, as the output of pterm already shows them
+func LogJSON(level string, message string, args ...interface{}) {
+	writeJSON(level, fmt.Sprintf(message, args...))
+}
+
+// JSONWriter returns a writer which writes every line as a message with the level, EG: for a clilogger.CLILogger.
+// Without JSON output, the lines are written to fallback
+func JSONWriter(level string, fallback io.Writer) io.Writer {
+	return jsonWriter{level: level, fallback: fallback}
+}
+
+type jsonWriter struct {
+	level    string
+	fallback io.Writer
+}
+
+func (w jsonWriter) Write(data []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !writeJSON(w.level, line) {
+			return w.fallback.Write(data)
+		}
+	}
+	return len(data), nil
+}
+
 func LogGreen(message string, args ...interface{}) {
 	if len(message) == 0 {
 		return
 	}
 	text := fmt.Sprintf(message, args...)
+	if writeJSON(LevelInfo, text) {
+		return
+	}
 	println(colour.Green(text))
 }
 
@@ -19,13 +106,28 @@ func LogRed(message string, args ...interface{}) {
 		return
 	}
 	text := fmt.Sprintf(message, args...)
+	if writeJSON(LevelError, text) {
+		return
+	}
 	println(colour.Red(text))
 }
 
+// LogDuration logs the message in green like LogGreen. The JSON output has got the duration in its durationMs field
+func LogDuration(duration time.Duration, message string, args ...interface{}) {
+	text := fmt.Sprintf(message, args...)
+	if writeJSONMessage(jsonMessage{Level: LevelInfo, Message: text, DurationMS: duration.Milliseconds()}) {
+		return
+	}
+	println(colour.Green(text))
+}
+
 func LogDarkYellow(message string, args ...interface{}) {
 	if len(message) == 0 {
 		return
 	}
 	text := fmt.Sprintf(message, args...)
+	if writeJSON(LevelWarning, text) {
+		return
+	}
 	println(colour.DarkYellow(text))
 }
@@ -0,0 +1,53 @@
+package logutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	var fallback bytes.Buffer
+	writer := JSONWriter(LevelInfo, &fallback)
+	_, err := writer.Write([]byte("Building application\n"))
+	require.NoError(t, err)
+	require.Equal(t, "Building application\n", fallback.String())
+
+	var output bytes.Buffer
+	EnableJSON(&output)
+	t.Cleanup(func() {
+		EnableJSON(nil)
+	})
+
+	LogGreen("Vite Server URL: %s", "http://localhost:5173/")
+	LogDarkYellow("Continuing to run current version")
+	LogRed("Build error - %s", "exit status 1")
+	LogJSON(LevelInfo, "Reloaded the frontend")
+	LogDuration(1500*time.Millisecond, "Rebuild completed in %s", "1.5s")
+	_, err = writer.Write([]byte("Done.\n\nBuilding application\n"))
+	require.NoError(t, err)
+
+	var messages []jsonMessage
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		var message jsonMessage
+		require.NoError(t, json.Unmarshal([]byte(line), &message))
+		_, err := time.Parse(time.RFC3339Nano, message.Timestamp)
+		require.NoError(t, err)
+		message.Timestamp = ""
+		messages = append(messages, message)
+	}
+	require.Equal(t, []jsonMessage{
+		{Level: LevelInfo, Message: "Vite Server URL: http://localhost:5173/"},
+		{Level: LevelWarning, Message: "Continuing to run current version"},
+		{Level: LevelError, Message: "Build error - exit status 1"},
+		{Level: LevelInfo, Message: "Reloaded the frontend"},
+		{Level: LevelInfo, Message: "Rebuild completed in 1.5s", DurationMS: 1500},
+		{Level: LevelInfo, Message: "Done."},
+		{Level: LevelInfo, Message: "Building application"},
+	}, messages)
+	require.Equal(t, "Building application\n", fallback.String())
+}
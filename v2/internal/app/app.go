@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"os"
+	"strconv"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
@@ -30,6 +32,48 @@ type App struct {
 	ctx              context.Context
 }
 
+// launchArgs returns the command line arguments exposed by runtime.LaunchArgs
+func launchArgs(appoptions *options.App) []string {
+	args := append([]string{}, os.Args[1:]...)
+	if appoptions.LaunchArgsFilter != nil {
+		return appoptions.LaunchArgsFilter(args)
+	}
+	return args
+}
+
+// safeModeEnvironmentVariable starts the application in safe mode if it's set to "1" or "true", `wails dev -safemode`
+// sets it as well
+const safeModeEnvironmentVariable = "WAILS_SAFEMODE"
+
+// IsSafeMode returns true if the application has been started in safe mode
+func IsSafeMode() bool {
+	value, _ := strconv.ParseBool(os.Getenv(safeModeEnvironmentVariable))
+	return value
+}
+
+// applySafeMode removes the customisations of the application which might keep it from starting, so that the core
+// shell can be used to find out which of them is broken: user scripts, custom schemes, the asset handler and
+// middleware, the main page hook, the saved window state and the splash screen
+func applySafeMode(appoptions *options.App, myLogger *logger.Logger) {
+	appoptions.UserScripts = nil
+	appoptions.CustomSchemes = nil
+	appoptions.AssetsHandler = nil
+	if appoptions.AssetServer != nil {
+		appoptions.AssetServer.Handler = nil
+		appoptions.AssetServer.Middleware = nil
+	}
+	appoptions.OnServeMainPage = nil
+	appoptions.WindowStatePath = ""
+	appoptions.SplashScreen = nil
+	appoptions.Title += " (Safe Mode)"
+
+	myLogger.Print("****************************************************************")
+	myLogger.Print("SAFE MODE: user scripts, custom schemes, the asset handler and")
+	myLogger.Print("middleware, OnServeMainPage, the saved window state and the")
+	myLogger.Print("splash screen are disabled. Unset " + safeModeEnvironmentVariable + " to leave safe mode.")
+	myLogger.Print("****************************************************************")
+}
+
 // Shutdown the application
 func (a *App) Shutdown() {
 	if a.frontend != nil {
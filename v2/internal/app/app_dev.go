@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
@@ -35,6 +36,9 @@ func (a *App) Run() error {
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+	if code := a.frontend.ExitCode(); code != 0 {
+		os.Exit(code)
+	}
 	return err
 }
 
@@ -62,6 +66,7 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	var devServerFlag *string
 	var frontendDevServerURLFlag *string
 	var loglevelFlag *string
+	var dumpAssetsFlag *bool
 
 	assetdir := os.Getenv("assetdir")
 	if assetdir == "" {
@@ -85,6 +90,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 	loglevelFlag = devFlags.String("loglevel", appLogLevel, "Loglevel to use - Trace, Debug, Info, Warning, Error")
 
+	dumpAssets := os.Getenv("dumpassets") == "true"
+	if !dumpAssets {
+		dumpAssetsFlag = devFlags.Bool("dumpassets", false, "Log the path and size of every asset")
+	}
+
 	// If we weren't given the assetdir in the environment variables
 	if assetdir == "" {
 		// Parse args but ignore errors in case -appargs was used to pass in args for the app.
@@ -101,6 +111,13 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		if loglevelFlag != nil {
 			loglevel = *loglevelFlag
 		}
+		if dumpAssetsFlag != nil {
+			dumpAssets = *dumpAssetsFlag
+		}
+	}
+
+	if IsSafeMode() {
+		applySafeMode(appoptions, myLogger)
 	}
 
 	assetConfig, err := assetserver.BuildAssetServerConfig(appoptions)
@@ -176,6 +193,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		ctx = context.WithValue(ctx, "devserver", devServer)
 	}
 
+	// Set by `wails dev` to log how long the frontend takes to become ready
+	if launchTime, err := strconv.ParseInt(os.Getenv("launchtime"), 10, 64); err == nil {
+		ctx = context.WithValue(ctx, "launchtime", time.Unix(0, launchTime))
+	}
+
 	if loglevel != "" {
 		level, err := pkglogger.StringToLogLevel(loglevel)
 		if err != nil {
@@ -187,9 +209,20 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		}
 	}
 
+	if dumpAssets {
+		if frontendDevServerURL != "" {
+			myLogger.Warning("The assets are served by the frontend DevServer '%s' and can't be listed.", frontendDevServerURL)
+		} else if assetConfig.Assets != nil {
+			if err := assetserver.LogAssets(assetConfig.Assets, myLogger.Info); err != nil {
+				myLogger.Error("Unable to list the assets: %s", err)
+			}
+		}
+	}
+
 	// Attach logger to context
 	ctx = context.WithValue(ctx, "logger", myLogger)
 	ctx = context.WithValue(ctx, "buildtype", "dev")
+	ctx = context.WithValue(ctx, "launchargs", launchArgs(appoptions))
 
 	// Preflight checks
 	err = PreflightChecks(appoptions, myLogger)
@@ -4,6 +4,7 @@ package app
 
 import (
 	"context"
+	"os"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
@@ -21,6 +22,9 @@ func (a *App) Run() error {
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+	if code := a.frontend.ExitCode(); code != 0 {
+		os.Exit(code)
+	}
 	return err
 }
 
@@ -47,6 +51,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 	ctx = context.WithValue(ctx, "logger", myLogger)
 	ctx = context.WithValue(ctx, "obfuscated", IsObfuscated())
+	ctx = context.WithValue(ctx, "launchargs", launchArgs(appoptions))
+
+	if IsSafeMode() {
+		applySafeMode(appoptions, myLogger)
+	}
 
 	// Preflight Checks
 	err = PreflightChecks(appoptions, myLogger)
@@ -0,0 +1,25 @@
+// Package buildinfo holds the version details of the application.
+// They are set at build time by the Wails build system using `-ldflags -X`.
+package buildinfo
+
+// ImportPath is used to build the `-X` flags
+const ImportPath = "github.com/wailsapp/wails/v2/internal/buildinfo"
+
+var (
+	// Version is the version of the application, EG: "1.0.0"
+	Version string
+
+	// Commit is the short hash of the commit the application was built from
+	Commit string
+
+	// Date is the time the application was built, in RFC3339 format
+	Date string
+)
+
+// GetVersion returns Version, falling back to the version in the application bundle if it wasn't set
+func GetVersion() string {
+	if Version != "" {
+		return Version
+	}
+	return bundleVersion()
+}
@@ -0,0 +1,33 @@
+//go:build darwin && cgo
+// +build darwin,cgo
+
+package buildinfo
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation
+#import <Foundation/Foundation.h>
+#include <stdlib.h>
+#include <string.h>
+
+static char* BundleShortVersion() {
+    NSString *version = [[NSBundle mainBundle] objectForInfoDictionaryKey:@"CFBundleShortVersionString"];
+    if ( version == nil ) {
+        return NULL;
+    }
+    return strdup([version UTF8String]);
+}
+*/
+import "C"
+
+import "unsafe"
+
+// bundleVersion returns CFBundleShortVersionString from the application bundle
+func bundleVersion() string {
+	version := C.BundleShortVersion()
+	if version == nil {
+		return ""
+	}
+	defer C.free(unsafe.Pointer(version))
+	return C.GoString(version)
+}
@@ -0,0 +1,8 @@
+//go:build !darwin || !cgo
+// +build !darwin !cgo
+
+package buildinfo
+
+func bundleVersion() string {
+	return ""
+}
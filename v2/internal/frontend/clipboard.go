@@ -0,0 +1,6 @@
+package frontend
+
+import "errors"
+
+// ErrClipboardEmpty is returned when the clipboard doesn't contain the requested kind of data
+var ErrClipboardEmpty = errors.New("the clipboard does not contain an image")
@@ -0,0 +1,58 @@
+package frontend
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// CrashLogger logs the crash reports
+type CrashLogger interface {
+	Error(format string, args ...interface{})
+}
+
+// crashExitCode is the exit code of an unrecovered panic
+const crashExitCode = 2
+
+// exit is replaced in tests
+var exit = os.Exit
+
+// ReportCrash recovers a panic, logs a crash report, calls options.App.OnCrash and exits the application.
+// It must be deferred directly at the start of the function to guard, EG: the processor goroutines.
+func ReportCrash(logger CrashLogger, appoptions *options.App, source string) {
+	value := recover()
+	if value == nil {
+		return
+	}
+
+	report := options.CrashReport{
+		Source: source,
+		Panic:  value,
+		Stack:  string(debug.Stack()),
+		Time:   time.Now(),
+	}
+	logger.Error("%s", FormatCrashReport(report))
+
+	if appoptions != nil && appoptions.OnCrash != nil {
+		func() {
+			// A panic in the callback must not prevent the exit
+			defer func() {
+				if value := recover(); value != nil {
+					logger.Error("OnCrash panicked: %v", value)
+				}
+			}()
+			appoptions.OnCrash(report)
+		}()
+	}
+
+	exit(crashExitCode)
+}
+
+// FormatCrashReport returns the text of the crash report which is logged
+func FormatCrashReport(report options.CrashReport) string {
+	return fmt.Sprintf("The application crashed\nSource: %s\nTime: %s\nPanic: %v\n\n%s",
+		report.Source, report.Time.Format(time.RFC3339), report.Panic, report.Stack)
+}
@@ -0,0 +1,74 @@
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+type recordingLogger struct {
+	lock   sync.Mutex
+	errors []string
+}
+
+func (r *recordingLogger) Error(format string, args ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestReportCrash(t *testing.T) {
+	defer func(previous func(int)) {
+		exit = previous
+	}(exit)
+	exitCode := -1
+	exit = func(code int) {
+		exitCode = code
+	}
+
+	logger := &recordingLogger{}
+	var report options.CrashReport
+	appoptions := &options.App{
+		OnCrash: func(r options.CrashReport) {
+			report = r
+			panic("the callback panics too")
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer ReportCrash(logger, appoptions, "message processor")
+		panic("boom")
+	}()
+	<-done
+
+	require.Equal(t, 2, exitCode)
+	require.Equal(t, "message processor", report.Source)
+	require.Equal(t, "boom", report.Panic)
+	require.Contains(t, report.Stack, "TestReportCrash")
+	require.False(t, report.Time.IsZero())
+
+	require.Len(t, logger.errors, 2)
+	require.Contains(t, logger.errors[0], "Source: message processor")
+	require.Contains(t, logger.errors[0], "Panic: boom")
+	require.Contains(t, logger.errors[1], "OnCrash panicked: the callback panics too")
+}
+
+func TestReportCrashWithoutPanic(t *testing.T) {
+	defer func(previous func(int)) {
+		exit = previous
+	}(exit)
+	exit = func(code int) {
+		t.Fatalf("unexpected exit with %d", code)
+	}
+
+	logger := &recordingLogger{}
+	func() {
+		defer ReportCrash(logger, nil, "startup")
+	}()
+	require.Empty(t, logger.errors)
+}
@@ -0,0 +1,97 @@
+package frontend
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// StyleSheetScript defines `window.wailsStyleSheets`, which adds, replaces and removes the `<style>`
+// elements injected by InjectCSS. Each element has the id "wails-css-<id>".
+const StyleSheetScript = `(function() {
+    if (window.wailsStyleSheets) {
+        return;
+    }
+    function find(id) {
+        return document.getElementById("wails-css-" + id);
+    }
+    window.wailsStyleSheets = {
+        set: function(id, css) {
+            var style = find(id);
+            if (!style) {
+                style = document.createElement("style");
+                style.id = "wails-css-" + id;
+                (document.head || document.documentElement).appendChild(style);
+            }
+            style.textContent = css;
+        },
+        remove: function(id) {
+            var style = find(id);
+            if (style) {
+                style.remove();
+            }
+        }
+    };
+})();`
+
+// InjectCSSJS returns the JS to add or replace the stylesheet with the given id.
+// StyleSheetScript is included so it can be executed on pages it hasn't been injected into
+func InjectCSSJS(id string, css string) string {
+	quotedID, _ := json.Marshal(id)
+	quotedCSS, _ := json.Marshal(css)
+	return StyleSheetScript + "window.wailsStyleSheets.set(" + string(quotedID) + ", " + string(quotedCSS) + ");"
+}
+
+// RemoveCSSJS returns the JS to remove the stylesheet with the given id
+func RemoveCSSJS(id string) string {
+	quotedID, _ := json.Marshal(id)
+	return "if (window.wailsStyleSheets) { window.wailsStyleSheets.remove(" + string(quotedID) + "); }"
+}
+
+// StyleSheets keeps the CSS injected by id, so it can be applied again when the page reloads.
+// The zero value is ready to use
+type StyleSheets struct {
+	lock sync.Mutex
+	ids  []string
+	css  map[string]string
+}
+
+// Set adds or replaces the stylesheet with the given id
+func (s *StyleSheets) Set(id string, css string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.css == nil {
+		s.css = map[string]string{}
+	}
+	if _, exists := s.css[id]; !exists {
+		s.ids = append(s.ids, id)
+	}
+	s.css[id] = css
+}
+
+// Remove removes the stylesheet with the given id
+func (s *StyleSheets) Remove(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, exists := s.css[id]; !exists {
+		return
+	}
+	delete(s.css, id)
+	for i, existing := range s.ids {
+		if existing == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// JS returns the JS to inject all stylesheets in the order they were added, or "" if there are none
+func (s *StyleSheets) JS() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var js strings.Builder
+	for _, id := range s.ids {
+		js.WriteString(InjectCSSJS(id, s.css[id]))
+	}
+	return js.String()
+}
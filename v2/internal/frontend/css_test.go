@@ -0,0 +1,31 @@
+package frontend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyleSheets(t *testing.T) {
+	var styleSheets StyleSheets
+	require.Equal(t, "", styleSheets.JS())
+
+	styleSheets.Set("theme", "body { color: red; }")
+	styleSheets.Set("user", "p { margin: 0; }")
+	styleSheets.Set("theme", "body { color: blue; }")
+
+	js := styleSheets.JS()
+	require.Equal(t, InjectCSSJS("theme", "body { color: blue; }")+InjectCSSJS("user", "p { margin: 0; }"), js)
+	require.False(t, strings.Contains(js, "red"))
+
+	styleSheets.Remove("theme")
+	styleSheets.Remove("missing")
+	require.Equal(t, InjectCSSJS("user", "p { margin: 0; }"), styleSheets.JS())
+}
+
+func TestInjectCSSJS(t *testing.T) {
+	js := InjectCSSJS(`a"b`, "</style><script>")
+	require.True(t, strings.HasPrefix(js, StyleSheetScript))
+	require.True(t, strings.HasSuffix(js, `window.wailsStyleSheets.set("a\"b", "\u003c/style\u003e\u003cscript\u003e");`))
+}
@@ -28,6 +28,10 @@
     return NSTerminateCancel;
 }
 
+- (void)applicationDidChangeScreenParameters:(NSNotification *)notification {
+    processMessage("wails:screen:changed");
+}
+
 - (void)applicationWillFinishLaunching:(NSNotification *)aNotification {
     [NSApp setActivationPolicy:NSApplicationActivationPolicyRegular];
     if (self.alwaysOnTop) {
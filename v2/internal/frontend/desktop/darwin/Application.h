@@ -17,13 +17,20 @@
 #define WindowStartsMinimised 2
 #define WindowStartsFullscreen 3
 
-WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int contentProtection, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop);
+WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int contentProtection, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop, const char* customSchemes);
 void Run(void*, const char* url);
 
 void SetTitle(void* ctx, const char *title);
+void SetIcon(void* ctx, const void *data, int length);
+void DockSetBadge(void* ctx, const char *label);
+void RequestUserAttention(void* ctx, int critical);
+void SetAppearance(void* ctx, const char *appearance);
 void Center(void* ctx);
+void CenterOnScreen(void* ctx, int index);
 void SetSize(void* ctx, int width, int height);
 void SetAlwaysOnTop(void* ctx, int onTop);
+void SetMinimizable(void* ctx, int minimizable);
+void SetMaximizable(void* ctx, int maximizable);
 void SetMinSize(void* ctx, int width, int height);
 void SetMaxSize(void* ctx, int width, int height);
 void SetPosition(void* ctx, int x, int y);
@@ -32,6 +39,7 @@ void UnFullscreen(void* ctx);
 void Minimise(void* ctx);
 void UnMinimise(void* ctx);
 void ToggleMaximise(void* ctx);
+void ShowZoomMenu(void* ctx);
 void Maximise(void* ctx);
 void UnMaximise(void* ctx);
 void Hide(void* ctx);
@@ -39,12 +47,41 @@ void Show(void* ctx);
 void HideApplication(void* ctx);
 void ShowApplication(void* ctx);
 void SetBackgroundColour(void* ctx, int r, int g, int b, int a);
+void SetWebViewBackgroundColour(void* ctx, int r, int g, int b, int a);
+void TrimMemory(void* ctx);
+void SetCollectionBehavior(void* ctx, unsigned long behaviour);
+void SetSpellCheckEnabled(void* ctx, int enabled);
+void SetEnabled(void* ctx, int enabled);
+void SetJavaScriptEnabled(void* ctx, int enabled);
+void SetShadow(void* ctx, int enabled);
+void SetCornerRadius(void* ctx, double radius);
+void GoBack(void* ctx);
+void GoForward(void* ctx);
+const bool CanGoBack(void* ctx);
+const bool CanGoForward(void* ctx);
+void StopLoading(void* ctx);
+void SetSpellCheckLanguage(void* ctx, const char* language);
 void ExecJS(void* ctx, const char*);
+void AddUserScript(void* ctx, const char* script, int atDocumentStart);
+void SetContentRules(void* ctx, const char* rules);
+void ClearContentRules(void* ctx);
+const bool IsMouseButtonPressed(void* ctx);
+bool StartDrag(void* ctx, const char* paths);
+void ExecJSWithResult(void* ctx, const char* script, int callbackID);
+void RunOnMainThread(int callbackID);
+bool SupportsFindInPage(void);
+void FindInPage(void* ctx, const char* query, int caseSensitive, int backwards);
+bool SupportsSessionState(void);
+void GetSessionState(void* ctx);
+void RestoreSessionState(void* ctx, const void* data, int length);
+void SetDownloadDestination(void* ctx, int downloadID, const char* path);
 void Quit(void*);
 void WindowPrint(void* ctx);
 
+const char* GetTitle(void *ctx);
 const char* GetSize(void *ctx);
 const char* GetPosition(void *ctx);
+const char* GetNormalGeometry(void *ctx);
 const bool IsFullScreen(void *ctx);
 const bool IsMinimised(void *ctx);
 const bool IsMaximised(void *ctx);
@@ -62,6 +99,8 @@ void AppendRole(void *inctx, void *inMenu, int role);
 void SetAsApplicationMenu(void *inctx, void *inMenu);
 void UpdateApplicationMenu(void *inctx);
 
+void ShowSplashScreen(void *inctx, void* imagedata, int datalen, const char* html, int width, int height);
+void HideSplashScreen(void *inctx);
 void SetAbout(void *inctx, const char* title, const char* description, void* imagedata, int datalen);
 void* AppendMenuItem(void* inctx, void* nsmenu, const char* label, const char* shortcutKey, int modifiers, int disabled, int checked, int menuItemID);
 void AppendSeparator(void* inMenu);
@@ -13,8 +13,9 @@
 #import "WindowDelegate.h"
 #import "WailsMenu.h"
 #import "WailsMenuItem.h"
+#import "message.h"
 
-WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int contentProtection, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop) {
+WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int contentProtection, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop, const char* customSchemes) {
 
     [NSApplication sharedApplication];
 
@@ -27,7 +28,7 @@ WailsContext* Create(const char* title, int width, int height, int frameless, in
         fullscreen = 1;
     }
 
-    [result CreateWindow:width :height :frameless :resizable :zoomable :fullscreen :fullSizeContent :hideTitleBar :titlebarAppearsTransparent :hideTitle :useToolbar :hideToolbarSeparator :webviewIsTransparent :hideWindowOnClose :safeInit(appearance) :windowIsTranslucent :minWidth :minHeight :maxWidth :maxHeight :fraudulentWebsiteWarningEnabled :preferences :enableDragAndDrop :disableWebViewDragAndDrop];
+    [result CreateWindow:width :height :frameless :resizable :zoomable :fullscreen :fullSizeContent :hideTitleBar :titlebarAppearsTransparent :hideTitle :useToolbar :hideToolbarSeparator :webviewIsTransparent :hideWindowOnClose :safeInit(appearance) :windowIsTranslucent :minWidth :minHeight :maxWidth :maxHeight :fraudulentWebsiteWarningEnabled :preferences :enableDragAndDrop :disableWebViewDragAndDrop :safeInit(customSchemes)];
     [result SetTitle:safeInit(title)];
     [result Center];
 
@@ -73,6 +74,122 @@ void ExecJS(void* inctx, const char *script) {
     );
 }
 
+void AddUserScript(void* inctx, const char *script, int atDocumentStart) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *nsscript = safeInit(script);
+    ON_MAIN_THREAD(
+       [ctx AddUserScript:nsscript :atDocumentStart];
+       [nsscript release];
+    );
+}
+
+void SetContentRules(void* inctx, const char *rules) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_rules = safeInit(rules);
+    ON_MAIN_THREAD(
+       [ctx SetContentRules:_rules];
+       [_rules release];
+    );
+}
+
+void ClearContentRules(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx ClearContentRules];
+    );
+}
+
+const bool IsMouseButtonPressed(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    return ctx.mouseEvent != nil;
+}
+
+// StartDrag waits for the main thread, so it can report whether the dragging session has started
+bool StartDrag(void* inctx, const char *paths) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSData *_paths = [NSData dataWithBytes:paths length:strlen(paths)];
+    __block bool started = false;
+    void (^startDrag)(void) = ^{
+        NSArray *pathList = [NSJSONSerialization JSONObjectWithData:_paths options:0 error:nil];
+        if ( pathList != nil ) {
+            started = [ctx StartDrag:pathList];
+        }
+    };
+    if ( [NSThread isMainThread] ) {
+        startDrag();
+    } else {
+        dispatch_sync(dispatch_get_main_queue(), startDrag);
+    }
+    return started;
+}
+
+void ExecJSWithResult(void* inctx, const char *script, int callbackID) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *nsscript = safeInit(script);
+    ON_MAIN_THREAD(
+       [ctx ExecJSWithResult:nsscript :callbackID];
+       [nsscript release];
+    );
+}
+
+void RunOnMainThread(int callbackID) {
+    if ([NSThread isMainThread]) {
+        processMainThreadCallback(callbackID);
+        return;
+    }
+    ON_MAIN_THREAD(
+       processMainThreadCallback(callbackID);
+    );
+}
+
+bool SupportsFindInPage(void) {
+    if (@available(macOS 11.0, *)) {
+        return true;
+    }
+    return false;
+}
+
+void FindInPage(void* inctx, const char *query, int caseSensitive, int backwards) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_query = safeInit(query);
+    ON_MAIN_THREAD(
+       [ctx FindInPage:_query :caseSensitive :backwards];
+       [_query release];
+    );
+}
+
+bool SupportsSessionState(void) {
+    if (@available(macOS 12.0, *)) {
+        return true;
+    }
+    return false;
+}
+
+void GetSessionState(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx GetSessionState];
+    );
+}
+
+void RestoreSessionState(void* inctx, const void *data, int length) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSData *_data = [[NSData alloc] initWithBytes:data length:length];
+    ON_MAIN_THREAD(
+       [ctx RestoreSessionState:_data];
+       [_data release];
+    );
+}
+
+void SetDownloadDestination(void* inctx, int downloadID, const char *path) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_path = safeInit(path);
+    ON_MAIN_THREAD(
+       [ctx SetDownloadDestination:downloadID :_path];
+       [_path release];
+    );
+}
+
 void SetTitle(void* inctx, const char *title) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     NSString *_title = safeInit(title);
@@ -82,6 +199,40 @@ void SetTitle(void* inctx, const char *title) {
 }
 
 
+void SetIcon(void* inctx, const void *data, int length) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSData *_data = [[NSData alloc] initWithBytes:data length:length];
+    ON_MAIN_THREAD(
+       [ctx SetIcon:_data];
+       [_data release];
+    );
+}
+
+void DockSetBadge(void* inctx, const char *label) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_label = safeInit(label);
+    ON_MAIN_THREAD(
+       [ctx DockSetBadge:_label];
+       [_label release];
+    );
+}
+
+void RequestUserAttention(void* inctx, int critical) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx RequestUserAttention:critical];
+    );
+}
+
+void SetAppearance(void* inctx, const char *appearance) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_appearance = safeInit(appearance);
+    ON_MAIN_THREAD(
+       [ctx SetAppearance:_appearance];
+       [_appearance release];
+    );
+}
+
 void SetBackgroundColour(void *inctx, int r, int g, int b, int a) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -89,6 +240,71 @@ void SetBackgroundColour(void *inctx, int r, int g, int b, int a) {
     );
 }
 
+void SetWebViewBackgroundColour(void *inctx, int r, int g, int b, int a) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetWebViewBackgroundColour:r :g :b :a];
+    );
+}
+
+void TrimMemory(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx TrimMemory];
+    );
+}
+
+void SetCollectionBehavior(void *inctx, unsigned long behaviour) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx.mainWindow setCollectionBehavior:behaviour];
+    );
+}
+
+void SetSpellCheckEnabled(void *inctx, int enabled) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetSpellCheckEnabled:enabled];
+    );
+}
+
+void SetEnabled(void *inctx, int enabled) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetEnabled:enabled];
+    );
+}
+
+void SetJavaScriptEnabled(void *inctx, int enabled) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetJavaScriptEnabled:enabled];
+    );
+}
+
+void SetShadow(void *inctx, int enabled) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetShadow:enabled];
+    );
+}
+
+void SetCornerRadius(void *inctx, double radius) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetCornerRadius:radius];
+    );
+}
+
+void SetSpellCheckLanguage(void *inctx, const char* language) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_language = safeInit(language);
+    ON_MAIN_THREAD(
+       [ctx SetSpellCheckLanguage:_language];
+       [_language release];
+    );
+}
+
 void SetSize(void* inctx, int width, int height) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -103,6 +319,20 @@ void SetAlwaysOnTop(void* inctx, int onTop) {
     );
 }
 
+void SetMinimizable(void* inctx, int minimizable) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetMinimizable:minimizable];
+    );
+}
+
+void SetMaximizable(void* inctx, int maximizable) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetMaximizable:maximizable];
+    );
+}
+
 void SetMinSize(void* inctx, int width, int height) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -131,6 +361,13 @@ void Center(void* inctx) {
     );
 }
 
+void CenterOnScreen(void* inctx, int index) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx CenterOnScreen:index];
+    );
+}
+
 void Fullscreen(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -166,6 +403,13 @@ void Maximise(void* inctx) {
     );
 }
 
+void ShowZoomMenu(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx ShowZoomMenu];
+    );
+}
+
 void ToggleMaximise(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -173,6 +417,11 @@ void ToggleMaximise(void* inctx) {
     );
 }
 
+const char* GetTitle(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    return [[ctx.mainWindow title] UTF8String];
+}
+
 const char* GetSize(void *inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     NSRect frame = [ctx.mainWindow frame];
@@ -192,6 +441,18 @@ const char* GetPosition(void *inctx) {
     return [result UTF8String];
 }
 
+const char* GetNormalGeometry(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSScreen* screen = [ctx getCurrentScreen];
+    NSRect windowFrame = [ctx GetNormalFrame];
+    NSRect screenFrame = [screen visibleFrame];
+    int x = windowFrame.origin.x - screenFrame.origin.x;
+    int y = windowFrame.origin.y - screenFrame.origin.y;
+    y = screenFrame.size.height - y - windowFrame.size.height;
+    NSString *result = [NSString stringWithFormat:@"%d,%d,%d,%d", x, y, (int)windowFrame.size.width, (int)windowFrame.size.height];
+    return [result UTF8String];
+}
+
 const bool IsFullScreen(void *inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     return [ctx IsFullScreen];
@@ -202,6 +463,37 @@ const bool IsMinimised(void *inctx) {
     return [ctx IsMinimised];
 }
 
+void GoBack(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx.webview goBack];
+    );
+}
+
+void GoForward(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx.webview goForward];
+    );
+}
+
+void StopLoading(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx.webview stopLoading];
+    );
+}
+
+const bool CanGoBack(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    return [ctx.webview canGoBack];
+}
+
+const bool CanGoForward(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    return [ctx.webview canGoForward];
+}
+
 const bool IsMaximised(void *inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     return [ctx IsMaximised];
@@ -336,6 +628,24 @@ void UpdateApplicationMenu(void *inctx) {
     )
 }
 
+void ShowSplashScreen(void *inctx, void* imagedata, int datalen, const char* html, int width, int height) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSData *_imageData = nil;
+    if ( imagedata != nil && datalen > 0 ) {
+        _imageData = [NSData dataWithBytes:imagedata length:datalen];
+    }
+    NSString *_html = safeInit(html);
+
+    [ctx ShowSplashScreen :_imageData :_html :width :height];
+}
+
+void HideSplashScreen(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+        [ctx HideSplashScreen];
+    );
+}
+
 void SetAbout(void *inctx, const char* title, const char* description, void* imagedata, int datalen) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     NSString *_title = safeInit(title);
@@ -11,6 +11,7 @@
 #import <Cocoa/Cocoa.h>
 #import <WebKit/WebKit.h>
 #import "WailsWebView.h"
+#import "WailsDownload.h"
 
 #if __has_include(<UniformTypeIdentifiers/UTType.h>)
 #define USE_NEW_FILTERS
@@ -24,13 +25,17 @@
 
 @property NSSize userMinSize;
 @property NSSize userMaxSize;
+@property bool inputDisabled;
+@property double cornerRadius;
+// The frame before the window got maximised or fullscreen
+@property NSRect normalFrame;
 
 - (BOOL) canBecomeKeyWindow;
 - (void) applyWindowConstraints;
 - (void) disableWindowConstraints;
 @end
 
-@interface WailsContext : NSObject <WKURLSchemeHandler,WKScriptMessageHandler,WKNavigationDelegate,WKUIDelegate>
+@interface WailsContext : NSObject <WKURLSchemeHandler,WKScriptMessageHandler,WKNavigationDelegate,WKUIDelegate,NSDraggingSource>
 
 @property (retain) WailsWindow* mainWindow;
 @property (retain) WailsWebView* webview;
@@ -47,6 +52,8 @@
 @property (retain) NSEvent* mouseEvent;
 
 @property bool alwaysOnTop;
+@property bool maximizeDisabled;
+@property NSInteger userAttentionRequest;
 
 @property bool devtoolsEnabled;
 @property bool defaultContextMenuEnabled;
@@ -55,35 +62,68 @@
 
 @property (retain) NSMenu* applicationMenu;
 
+@property (retain) NSWindow* splashWindow;
+
+@property (assign) dispatch_source_t memoryPressureSource;
+
 @property (retain) NSImage* aboutImage;
 @property (retain) NSString* aboutTitle;
 @property (retain) NSString* aboutDescription;
 
+@property (retain) WailsDownloadDelegate* downloadDelegate;
+
 struct Preferences {
   bool *tabFocusesLinks;
   bool *textInteractionEnabled;
   bool *fullscreenEnabled;
 };
 
-- (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)zoomable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent  :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString *)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences :(bool)enableDragAndDrop :(bool)disableWebViewDragAndDrop;
+- (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)zoomable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent  :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString *)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences :(bool)enableDragAndDrop :(bool)disableWebViewDragAndDrop :(NSString*)customSchemes;
 - (void) SetSize:(int)width :(int)height;
 - (void) SetPosition:(int)x :(int) y;
 - (void) SetMinSize:(int)minWidth :(int)minHeight;
 - (void) SetMaxSize:(int)maxWidth :(int)maxHeight;
 - (void) SetTitle:(NSString*)title;
+- (void) SetIcon:(NSData*)data;
+- (void) DockSetBadge:(NSString*)label;
+- (void) RequestUserAttention:(bool)critical;
+- (void) CancelUserAttentionRequest;
+- (void) SetAppearance:(NSString*)appearance;
 - (void) SetAlwaysOnTop:(int)onTop;
+- (void) SetMinimizable:(int)minimizable;
+- (void) SetMaximizable:(int)maximizable;
 - (void) Center;
+- (void) CenterOnScreen:(int)index;
 - (void) Fullscreen;
 - (void) UnFullscreen;
 - (bool) IsFullScreen;
+- (NSRect) GetNormalFrame;
 - (void) Minimise;
 - (void) UnMinimise;
 - (bool) IsMinimised;
 - (void) Maximise;
 - (void) ToggleMaximise;
 - (void) UnMaximise;
+- (void) ShowZoomMenu;
 - (bool) IsMaximised;
 - (void) SetBackgroundColour:(int)r :(int)g :(int)b :(int)a;
+- (void) SetWebViewBackgroundColour:(int)r :(int)g :(int)b :(int)a;
+- (void) TrimMemory;
+- (void) SetSpellCheckEnabled:(bool)enabled;
+- (void) SetEnabled:(bool)enabled;
+- (void) SetJavaScriptEnabled:(bool)enabled;
+- (void) SetShadow:(bool)enabled;
+- (void) SetCornerRadius:(double)radius;
+- (void) SetSpellCheckLanguage:(NSString*)language;
+- (void) AddUserScript:(NSString*)script :(bool)atDocumentStart;
+- (void) SetContentRules:(NSString*)rules;
+- (void) ClearContentRules;
+- (bool) StartDrag:(NSArray<NSString*>*)paths;
+- (void) ExecJSWithResult:(NSString*)script :(int)callbackID;
+- (void) FindInPage:(NSString*)query :(bool)caseSensitive :(bool)backwards;
+- (void) GetSessionState;
+- (void) RestoreSessionState:(NSData*)data;
+- (void) SetDownloadDestination:(int)downloadID :(NSString*)path;
 - (void) HideMouse;
 - (void) ShowMouse;
 - (void) Hide;
@@ -100,6 +140,8 @@ struct Preferences {
 - (void) ExecJS:(NSString*)script;
 - (NSScreen*) getCurrentScreen;
 
+- (void) ShowSplashScreen :(NSData*)imageData :(NSString*)html :(int)width :(int)height;
+- (void) HideSplashScreen;
 - (void) SetAbout :(NSString*)title :(NSString*)description :(void*)imagedata :(int)datalen;
 - (void) dealloc;
 
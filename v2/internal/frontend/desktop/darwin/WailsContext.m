@@ -17,6 +17,12 @@
 
 typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 
+// Implemented by WKWebView on macOS, but not declared in its public header
+@interface WKWebView (ContinuousSpellChecking)
+- (BOOL) isContinuousSpellCheckingEnabled;
+- (void) toggleContinuousSpellChecking:(id)sender;
+@end
+
 @implementation WailsWindow
 
 - (BOOL)canBecomeKeyWindow
@@ -34,6 +40,20 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self setMaxSize:NSMakeSize(FLT_MAX, FLT_MAX)];
 }
 
+- (void) sendEvent:(NSEvent *)event {
+    if (self.inputDisabled) {
+        NSEventMask inputEvents = NSEventMaskLeftMouseDown | NSEventMaskLeftMouseUp | NSEventMaskLeftMouseDragged |
+            NSEventMaskRightMouseDown | NSEventMaskRightMouseUp | NSEventMaskRightMouseDragged |
+            NSEventMaskOtherMouseDown | NSEventMaskOtherMouseUp | NSEventMaskOtherMouseDragged |
+            NSEventMaskMouseMoved | NSEventMaskScrollWheel | NSEventMaskKeyDown | NSEventMaskKeyUp |
+            NSEventMaskFlagsChanged | NSEventMaskMagnify | NSEventMaskRotate | NSEventMaskSwipe | NSEventMaskGesture;
+        if ( (NSEventMaskFromType([event type]) & inputEvents) != 0 ) {
+            return;
+        }
+    }
+    [super sendEvent:event];
+}
+
 @end
 
 @implementation WailsContext
@@ -104,11 +124,17 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 }
 
 - (void) dealloc {
+    [self.webview removeObserver:self forKeyPath:@"canGoBack"];
+    [self.webview removeObserver:self forKeyPath:@"canGoForward"];
     [self.appdelegate release];
     [self.mainWindow release];
     [self.mouseEvent release];
     [self.userContentController release];
     [self.applicationMenu release];
+    if ( self.memoryPressureSource != nil ) {
+        dispatch_source_cancel(self.memoryPressureSource);
+        dispatch_release(self.memoryPressureSource);
+    }
     [super dealloc];
 }
 
@@ -124,6 +150,35 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self.mainWindow setTitle:title];
 }
 
+// SetIcon sets the image of the dock tile of the application
+- (void) SetIcon:(NSData*)data {
+    NSImage *icon = [[NSImage alloc] initWithData:data];
+    if (icon == nil) {
+        return;
+    }
+    [NSApp setApplicationIconImage:icon];
+    [icon release];
+}
+
+// RequestUserAttention bounces the dock icon if the application isn't active. A critical request bounces until the
+// application is activated, an informational one bounces once
+- (void) RequestUserAttention:(bool)critical {
+    [self CancelUserAttentionRequest];
+    self.userAttentionRequest = [NSApp requestUserAttention:(critical ? NSCriticalRequest : NSInformationalRequest)];
+}
+
+- (void) CancelUserAttentionRequest {
+    if (self.userAttentionRequest != 0) {
+        [NSApp cancelUserAttentionRequest:self.userAttentionRequest];
+        self.userAttentionRequest = 0;
+    }
+}
+
+// DockSetBadge sets the badge label of the dock tile, an empty label removes the badge
+- (void) DockSetBadge:(NSString*)label {
+    [[NSApp dockTile] setBadgeLabel:([label length] == 0 ? nil : label)];
+}
+
 - (void) Center {
      [self.mainWindow center];
 }
@@ -136,7 +191,7 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     return NO;
 }
 
-- (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)zoomable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString*)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences :(bool)enableDragAndDrop :(bool)disableWebViewDragAndDrop  {
+- (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)zoomable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString*)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences :(bool)enableDragAndDrop :(bool)disableWebViewDragAndDrop :(NSString*)customSchemes {
     NSWindowStyleMask styleMask = 0;
 
     if( !frameless ) {
@@ -218,6 +273,17 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     config.suppressesIncrementalRendering = true;
     config.applicationNameForUserAgent = @"wails.io";
     [config setURLSchemeHandler:self forURLScheme:@"wails"];
+    // The requests of custom schemes are routed to their handlers by the Go side
+    for (NSString *scheme in [customSchemes componentsSeparatedByString:@","]) {
+        if ( [scheme length] == 0 ) {
+            continue;
+        }
+        if ( [WKWebView handlesURLScheme:scheme] ) {
+            NSLog(@"Unable to register custom scheme '%@': it is handled by WebKit", scheme);
+            continue;
+        }
+        [config setURLSchemeHandler:self forURLScheme:scheme];
+    }
 
     if (preferences.tabFocusesLinks != NULL) {
         config.preferences.tabFocusesLinks = *preferences.tabFocusesLinks;
@@ -279,6 +345,9 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     }
 
     [self.webview setNavigationDelegate:self];
+    [self.webview addObserver:self forKeyPath:@"canGoBack" options:0 context:nil];
+    [self.webview addObserver:self forKeyPath:@"canGoForward" options:0 context:nil];
+    self.downloadDelegate = [[WailsDownloadDelegate new] autorelease];
     self.webview.UIDelegate = self;
 
     NSUserDefaults *defaults = [NSUserDefaults standardUserDefaults];
@@ -304,6 +373,18 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 
     self.applicationMenu = [NSMenu new];
 
+    // Memory pressure monitor
+    self.memoryPressureSource = dispatch_source_create(DISPATCH_SOURCE_TYPE_MEMORYPRESSURE, 0, DISPATCH_MEMORYPRESSURE_WARN | DISPATCH_MEMORYPRESSURE_CRITICAL, dispatch_get_main_queue());
+    dispatch_source_set_event_handler(self.memoryPressureSource, ^{
+        unsigned long status = dispatch_source_get_data(self.memoryPressureSource);
+        if ( status & DISPATCH_MEMORYPRESSURE_CRITICAL ) {
+            processMessage("wails:memorypressure:critical");
+        } else if ( status & DISPATCH_MEMORYPRESSURE_WARN ) {
+            processMessage("wails:memorypressure:warning");
+        }
+    });
+    dispatch_resume(self.memoryPressureSource);
+
 }
 
 - (NSMenuItem*) newMenuItem :(NSString*)title :(SEL)selector :(NSString*)key :(NSEventModifierFlags)flags {
@@ -342,9 +423,116 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 
     id colour = [NSColor colorWithCalibratedRed:red green:green blue:blue alpha:alpha ];
 
+    if (self.cornerRadius > 0) {
+        // The window is transparent to show the rounded corners, see SetCornerRadius
+        [[self.mainWindow contentView] layer].backgroundColor = [colour CGColor];
+        return;
+    }
     [self.mainWindow setBackgroundColor:colour];
 }
 
+- (void) SetWebViewBackgroundColour:(int)r :(int)g :(int)b :(int)a {
+    float red = r/255.0;
+    float green = g/255.0;
+    float blue = b/255.0;
+    float alpha = a/255.0;
+
+    NSColor *colour = [NSColor colorWithCalibratedRed:red green:green blue:blue alpha:alpha ];
+
+    // Stop the webview painting its default white background so the colour below shows through
+    // until the content paints
+    [self.webview setValue:[NSNumber numberWithBool:NO] forKey:@"drawsBackground"];
+    [self.webview setWantsLayer:YES];
+    self.webview.layer.backgroundColor = colour.CGColor;
+    if (@available(macOS 12.0, *)) {
+        [self.webview setUnderPageBackgroundColor:colour];
+    }
+}
+
+- (void) TrimMemory {
+    NSSet *dataTypes = [NSSet setWithArray:@[WKWebsiteDataTypeMemoryCache, WKWebsiteDataTypeDiskCache]];
+    NSDate *since = [NSDate dateWithTimeIntervalSince1970:0];
+    [self.webview.configuration.websiteDataStore removeDataOfTypes:dataTypes modifiedSince:since completionHandler:^{}];
+    [[NSURLCache sharedURLCache] removeAllCachedResponses];
+}
+
+// SetAppearance forces the appearance of the window and the webview, so that the webview's
+// prefers-color-scheme matches the native controls. An empty appearance follows the system
+- (void) SetAppearance:(NSString*)appearance {
+    NSAppearance *nsAppearance = nil;
+    if ( appearance != nil && [appearance length] > 0 ) {
+        nsAppearance = [NSAppearance appearanceNamed:appearance];
+    }
+    [self.mainWindow setAppearance:nsAppearance];
+    [self.webview setAppearance:nsAppearance];
+}
+
+- (void) SetSpellCheckEnabled:(bool)enabled {
+    // Toggled on the webview, the WebContinuousSpellCheckingEnabled user default would change it for all web views
+    if ( ![self.webview respondsToSelector:@selector(toggleContinuousSpellChecking:)] ) {
+        return;
+    }
+    if ( [self.webview isContinuousSpellCheckingEnabled] != enabled ) {
+        [self.webview toggleContinuousSpellChecking:nil];
+    }
+}
+
+- (void) SetJavaScriptEnabled:(bool)enabled {
+    // Applies to subsequent navigations
+    if (@available(macOS 11.0, *)) {
+        self.webview.configuration.defaultWebpagePreferences.allowsContentJavaScript = enabled;
+    } else {
+        self.webview.configuration.preferences.javaScriptEnabled = enabled;
+    }
+}
+
+- (void) SetShadow:(bool)enabled {
+    [self.mainWindow setHasShadow:enabled];
+    // Recalculate the shadow for the current content, otherwise the change only shows after the window is redrawn
+    [self.mainWindow invalidateShadow];
+}
+
+- (void) SetCornerRadius:(double)radius {
+    NSView *contentView = [self.mainWindow contentView];
+    [contentView setWantsLayer:YES];
+    if (radius > 0 && self.cornerRadius <= 0) {
+        // The corners outside of the clipped content must be transparent, so the background is drawn by the layer
+        contentView.layer.backgroundColor = [[self.mainWindow backgroundColor] CGColor];
+        [self.mainWindow setOpaque:NO];
+        [self.mainWindow setBackgroundColor:[NSColor clearColor]];
+    } else if (radius <= 0 && self.cornerRadius > 0) {
+        [self.mainWindow setBackgroundColor:[NSColor colorWithCGColor:contentView.layer.backgroundColor]];
+        contentView.layer.backgroundColor = nil;
+    }
+    self.cornerRadius = radius;
+    contentView.layer.cornerRadius = radius > 0 ? radius : 0;
+    contentView.layer.masksToBounds = radius > 0;
+    // The shadow follows the shape of the content
+    [self.mainWindow invalidateShadow];
+}
+
+- (void) SetEnabled:(bool)enabled {
+    self.mainWindow.inputDisabled = !enabled;
+    [[self.mainWindow standardWindowButton:NSWindowCloseButton] setEnabled:enabled];
+    [[self.mainWindow standardWindowButton:NSWindowMiniaturizeButton] setEnabled:enabled];
+    [[self.mainWindow standardWindowButton:NSWindowZoomButton] setEnabled:enabled];
+    if ( enabled ) {
+        [self.mainWindow makeFirstResponder:self.webview];
+    } else {
+        [self.mainWindow makeFirstResponder:nil];
+    }
+}
+
+- (void) SetSpellCheckLanguage:(NSString*)language {
+    NSSpellChecker *spellChecker = [NSSpellChecker sharedSpellChecker];
+    if ( language == nil || [language length] == 0 ) {
+        [spellChecker setAutomaticallyIdentifiesLanguages:YES];
+        return;
+    }
+    [spellChecker setAutomaticallyIdentifiesLanguages:NO];
+    [spellChecker setLanguage:language];
+}
+
 - (void) HideMouse {
     [NSCursor hide];
 }
@@ -358,6 +546,14 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     return (mask & NSWindowStyleMaskFullScreen) == NSWindowStyleMaskFullScreen;
 }
 
+- (NSRect) GetNormalFrame {
+    if ([self IsFullScreen] || [self.mainWindow isZoomed]) {
+        // NSZeroRect if the window has been maximised before the frame could be recorded
+        return self.normalFrame;
+    }
+    return [self.mainWindow frame];
+}
+
 // Fullscreen sets the main window to be fullscreen
 - (void) Fullscreen {
     if( ! [self IsFullScreen] ) {
@@ -407,18 +603,66 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 
 - (void) Maximise {
     if (![self.mainWindow isZoomed]) {
-        [self.mainWindow zoom:nil];
+        [self zoom];
     }
 }
 
 - (void) ToggleMaximise {
-        [self.mainWindow zoom:nil];
+        [self zoom];
 }
 
 - (void) UnMaximise {
     if ([self.mainWindow isZoomed]) {
-        [self.mainWindow zoom:nil];
+        [self zoom];
+    }
+}
+
+- (void) CenterOnScreen:(int)index {
+    NSArray<NSScreen *> *screens = [NSScreen screens];
+    if (index < 0 || index >= (int)[screens count]) {
+        return;
+    }
+    NSRect visibleFrame = [[screens objectAtIndex:index] visibleFrame];
+    NSRect frame = [self.mainWindow frame];
+    frame.origin.x = NSMidX(visibleFrame) - NSWidth(frame) / 2;
+    frame.origin.y = NSMidY(visibleFrame) - NSHeight(frame) / 2;
+    [self.mainWindow setFrame:frame display:YES];
+}
+
+// ShowZoomMenu shows the window management actions of the menu of the zoom button below it, or at the mouse location
+// if the window hasn't got a zoom button
+- (void) ShowZoomMenu {
+    NSMenu *menu = [[NSMenu alloc] initWithTitle:@""];
+    if (!self.maximizeDisabled) {
+        bool fullscreen = ([self.mainWindow styleMask] & NSWindowStyleMaskFullScreen) == NSWindowStyleMaskFullScreen;
+        NSMenuItem *item = [menu addItemWithTitle:(fullscreen ? @"Exit Full Screen" : @"Enter Full Screen") action:@selector(toggleFullScreen:) keyEquivalent:@""];
+        [item setTarget:self.mainWindow];
+        if (!fullscreen) {
+            item = [menu addItemWithTitle:@"Zoom" action:@selector(performZoom:) keyEquivalent:@""];
+            [item setTarget:self.mainWindow];
+        }
+    }
+    if ([self.mainWindow styleMask] & NSWindowStyleMaskMiniaturizable) {
+        NSMenuItem *item = [menu addItemWithTitle:@"Minimize" action:@selector(performMiniaturize:) keyEquivalent:@""];
+        [item setTarget:self.mainWindow];
+    }
+
+    NSButton *zoomButton = [self.mainWindow standardWindowButton:NSWindowZoomButton];
+    if (zoomButton != nil && ![zoomButton isHidden]) {
+        CGFloat bottom = [zoomButton isFlipped] ? NSHeight([zoomButton bounds]) : 0;
+        [menu popUpMenuPositioningItem:nil atLocation:NSMakePoint(0, bottom) inView:zoomButton];
+    } else {
+        [menu popUpMenuPositioningItem:nil atLocation:[NSEvent mouseLocation] inView:nil];
     }
+    [menu release];
+}
+
+// zoom changes the zoom state even if the window has been made not maximizable, which only stops the user from doing so
+- (void) zoom {
+    bool maximizeDisabled = self.maximizeDisabled;
+    self.maximizeDisabled = false;
+    [self.mainWindow zoom:nil];
+    self.maximizeDisabled = maximizeDisabled;
 }
 
 - (void) SetAlwaysOnTop:(int)onTop {
@@ -429,6 +673,28 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     }
 }
 
+- (void) SetMinimizable:(int)minimizable {
+    if (minimizable) {
+        self.mainWindow.styleMask |= NSWindowStyleMaskMiniaturizable;
+    } else {
+        self.mainWindow.styleMask &= ~NSWindowStyleMaskMiniaturizable;
+    }
+    [[self.mainWindow standardWindowButton:NSWindowMiniaturizeButton] setEnabled:minimizable];
+}
+
+- (void) SetMaximizable:(int)maximizable {
+    // windowShouldZoom refuses to zoom, so double clicking the title bar and the Zoom menu item do nothing either
+    self.maximizeDisabled = !maximizable;
+    [[self.mainWindow standardWindowButton:NSWindowZoomButton] setEnabled:maximizable];
+    NSWindowCollectionBehavior behavior = [self.mainWindow collectionBehavior];
+    if (maximizable) {
+        behavior &= ~NSWindowCollectionBehaviorFullScreenNone;
+    } else {
+        behavior |= NSWindowCollectionBehaviorFullScreenNone;
+    }
+    [self.mainWindow setCollectionBehavior:behavior];
+}
+
 - (bool) IsMaximised {
     return [self.mainWindow isZoomed];
 }
@@ -437,6 +703,131 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
    [self.webview evaluateJavaScript:script completionHandler:nil];
 }
 
+- (void) AddUserScript:(NSString*)script :(bool)atDocumentStart {
+    WKUserScriptInjectionTime injectionTime = atDocumentStart ? WKUserScriptInjectionTimeAtDocumentStart : WKUserScriptInjectionTimeAtDocumentEnd;
+    WKUserScript *userScript = [[WKUserScript alloc] initWithSource:script injectionTime:injectionTime forMainFrameOnly:false];
+    [self.userContentController addUserScript:userScript];
+    [userScript release];
+}
+
+- (bool) StartDrag:(NSArray<NSString*>*)paths {
+    // Dragging sessions can only be started while the mouse button is pressed
+    if ( self.mouseEvent == nil ) {
+        return false;
+    }
+
+    NSPoint location = [self.webview convertPoint:[self.mouseEvent locationInWindow] fromView:nil];
+    NSMutableArray *draggingItems = [NSMutableArray new];
+    for (NSString *path in paths) {
+        NSURL *url = [NSURL fileURLWithPath:path];
+        NSDraggingItem *draggingItem = [[NSDraggingItem alloc] initWithPasteboardWriter:url];
+        NSImage *icon = [[NSWorkspace sharedWorkspace] iconForFile:path];
+        [icon setSize:NSMakeSize(32, 32)];
+        [draggingItem setDraggingFrame:NSMakeRect(location.x - 16, location.y - 16, 32, 32) contents:icon];
+        [draggingItems addObject:draggingItem];
+        [draggingItem release];
+    }
+
+    NSDraggingSession *session = [self.webview beginDraggingSessionWithItems:draggingItems event:self.mouseEvent source:self];
+    [draggingItems release];
+    return session != nil;
+}
+
+- (NSDragOperation)draggingSession:(NSDraggingSession *)session sourceOperationMaskForDraggingContext:(NSDraggingContext)context {
+    return NSDragOperationCopy;
+}
+
+- (void) ExecJSWithResult:(NSString*)script :(int)callbackID {
+    [self.webview evaluateJavaScript:script completionHandler:^(id result, NSError *error) {
+        if (error != nil) {
+            processExecJSResult(callbackID, NULL, [[error localizedDescription] UTF8String]);
+            return;
+        }
+        // The script is expected to return a JSON encoded string
+        if ( ![result isKindOfClass:[NSString class]] ) {
+            processExecJSResult(callbackID, "null", NULL);
+            return;
+        }
+        processExecJSResult(callbackID, [(NSString*)result UTF8String], NULL);
+    }];
+}
+
+- (void) FindInPage:(NSString*)query :(bool)caseSensitive :(bool)backwards {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110000
+    if (@available(macOS 11.0, *)) {
+        WKFindConfiguration *config = [WKFindConfiguration new];
+        config.caseSensitive = caseSensitive;
+        config.backwards = backwards;
+        config.wraps = true;
+        [self.webview findString:query withConfiguration:config completionHandler:^(WKFindResult *result) {}];
+        [config release];
+    }
+#endif
+}
+
+- (void) GetSessionState {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 120000
+    if (@available(macOS 12.0, *)) {
+        id state = self.webview.interactionState;
+        if (state == nil) {
+            processSessionState(NULL, 0, "no session state available");
+            return;
+        }
+        NSError *error = nil;
+        NSData *data = [NSKeyedArchiver archivedDataWithRootObject:state requiringSecureCoding:NO error:&error];
+        if (data == nil) {
+            processSessionState(NULL, 0, [[error localizedDescription] UTF8String]);
+            return;
+        }
+        processSessionState((void*)[data bytes], (int)[data length], "");
+        return;
+    }
+#endif
+    processSessionState(NULL, 0, "session state requires macOS 12 or later");
+}
+
+- (void) RestoreSessionState:(NSData*)data {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 120000
+    if (@available(macOS 12.0, *)) {
+        NSError *error = nil;
+        NSKeyedUnarchiver *unarchiver = [[NSKeyedUnarchiver alloc] initForReadingFromData:data error:&error];
+        if (unarchiver == nil) {
+            processRestoreSessionStateResponse([[error localizedDescription] UTF8String]);
+            return;
+        }
+        unarchiver.requiresSecureCoding = NO;
+        id state = [unarchiver decodeObjectForKey:NSKeyedArchiveRootObjectKey];
+        [unarchiver finishDecoding];
+        [unarchiver release];
+        if (state == nil) {
+            processRestoreSessionStateResponse("invalid session state");
+            return;
+        }
+        self.webview.interactionState = state;
+        processRestoreSessionStateResponse("");
+        return;
+    }
+#endif
+    processRestoreSessionStateResponse("session state requires macOS 12 or later");
+}
+
+- (void) SetContentRules:(NSString*)rules {
+    WKContentRuleListStore *store = [WKContentRuleListStore defaultStore];
+    [store compileContentRuleListForIdentifier:@"wails" encodedContentRuleList:rules completionHandler:^(WKContentRuleList *ruleList, NSError *error) {
+        if (error != nil) {
+            processContentRulesResponse([[error localizedDescription] UTF8String]);
+            return;
+        }
+        [self.userContentController removeAllContentRuleLists];
+        [self.userContentController addContentRuleList:ruleList];
+        processContentRulesResponse("");
+    }];
+}
+
+- (void) ClearContentRules {
+    [self.userContentController removeAllContentRuleLists];
+}
+
 - (void)webView:(WKWebView *)webView runOpenPanelWithParameters:(WKOpenPanelParameters *)parameters
     initiatedByFrame:(WKFrameInfo *)frame completionHandler:(void (^)(NSArray<NSURL *> * URLs))completionHandler {
 
@@ -472,10 +863,100 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     }
 }
 
+- (void) processNavigation:(NSString*)kind :(NSURL*)url :(NSError*)error {
+    NSMutableDictionary *event = [NSMutableDictionary dictionary];
+    event[@"url"] = url != nil ? [url absoluteString] : @"";
+    if (error != nil) {
+        event[@"error"] = [error localizedDescription];
+    }
+    NSData *data = [NSJSONSerialization dataWithJSONObject:event options:0 error:nil];
+    NSString *json = [[[NSString alloc] initWithData:data encoding:NSUTF8StringEncoding] autorelease];
+    NSString *message = [NSString stringWithFormat:@"wails:navigation:%@:%@", kind, json];
+    processMessage([message UTF8String]);
+}
+
+- (void)webView:(WKWebView *)webView didStartProvisionalNavigation:(WKNavigation *)navigation {
+    [self processNavigation:@"started" :webView.URL :nil];
+}
+
+- (void)webView:(WKWebView *)webView didFailProvisionalNavigation:(WKNavigation *)navigation withError:(NSError *)error {
+    [self processNavigationError:webView :error];
+}
+
+- (void)webView:(WKWebView *)webView didFailNavigation:(WKNavigation *)navigation withError:(NSError *)error {
+    [self processNavigationError:webView :error];
+}
+
+- (void) processNavigationError:(WKWebView *)webView :(NSError *)error {
+    // A response that became a download interrupts the navigation, that's not a failure
+    if ([error.domain isEqualToString:@"WebKitErrorDomain"] && error.code == 102) {
+        return;
+    }
+    NSURL *url = error.userInfo[NSURLErrorFailingURLErrorKey];
+    if (url == nil) {
+        url = webView.URL;
+    }
+    [self processNavigation:@"failed" :url :error];
+}
+
 - (void)webView:(WKWebView *)webView didFinishNavigation:(WKNavigation *)navigation {
+    [self processNavigation:@"finished" :webView.URL :nil];
     processMessage("DomReady");
 }
 
+- (void)observeValueForKeyPath:(NSString *)keyPath ofObject:(id)object change:(NSDictionary *)change context:(void *)context {
+    if (object == self.webview && ([keyPath isEqualToString:@"canGoBack"] || [keyPath isEqualToString:@"canGoForward"])) {
+        NSString *message = [NSString stringWithFormat:@"wails:history:%d,%d", self.webview.canGoBack, self.webview.canGoForward];
+        processMessage([message UTF8String]);
+        return;
+    }
+    [super observeValueForKeyPath:keyPath ofObject:object change:change context:context];
+}
+
+/***** Downloads ******/
+- (void)webView:(WKWebView *)webView decidePolicyForNavigationAction:(WKNavigationAction *)navigationAction decisionHandler:(void (^)(WKNavigationActionPolicy))decisionHandler {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+    if (@available(macOS 11.3, *)) {
+        if (navigationAction.shouldPerformDownload) {
+            decisionHandler(WKNavigationActionPolicyDownload);
+            return;
+        }
+    }
+#endif
+    decisionHandler(WKNavigationActionPolicyAllow);
+}
+
+- (void)webView:(WKWebView *)webView decidePolicyForNavigationResponse:(WKNavigationResponse *)navigationResponse decisionHandler:(void (^)(WKNavigationResponsePolicy))decisionHandler {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+    if (@available(macOS 11.3, *)) {
+        bool attachment = false;
+        if ([navigationResponse.response isKindOfClass:[NSHTTPURLResponse class]]) {
+            NSString *disposition = [(NSHTTPURLResponse*)navigationResponse.response valueForHTTPHeaderField:@"Content-Disposition"];
+            attachment = disposition != nil && [[disposition lowercaseString] hasPrefix:@"attachment"];
+        }
+        if (attachment || (navigationResponse.isForMainFrame && !navigationResponse.canShowMIMEType)) {
+            decisionHandler(WKNavigationResponsePolicyDownload);
+            return;
+        }
+    }
+#endif
+    decisionHandler(WKNavigationResponsePolicyAllow);
+}
+
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+- (void)webView:(WKWebView *)webView navigationAction:(WKNavigationAction *)navigationAction didBecomeDownload:(WKDownload *)download API_AVAILABLE(macos(11.3)) {
+    [self.downloadDelegate track:download];
+}
+
+- (void)webView:(WKWebView *)webView navigationResponse:(WKNavigationResponse *)navigationResponse didBecomeDownload:(WKDownload *)download API_AVAILABLE(macos(11.3)) {
+    [self.downloadDelegate track:download];
+}
+#endif
+
+- (void) SetDownloadDestination:(int)downloadID :(NSString*)path {
+    [self.downloadDelegate SetDestination:downloadID :path];
+}
+
 - (void)userContentController:(nonnull WKUserContentController *)userContentController didReceiveScriptMessage:(nonnull WKScriptMessage *)message {
     // Get the origin from the message's frame
     NSString *origin = nil;
@@ -717,6 +1198,38 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 
 }
 
+- (void) ShowSplashScreen :(NSData*)imageData :(NSString*)html :(int)width :(int)height {
+    NSRect frame = NSMakeRect(0, 0, width, height);
+    NSWindow *splash = [[NSWindow alloc] initWithContentRect:frame styleMask:NSWindowStyleMaskBorderless backing:NSBackingStoreBuffered defer:NO];
+    [splash setReleasedWhenClosed:NO];
+    [splash setBackgroundColor:[self.mainWindow backgroundColor]];
+
+    if ( imageData != nil ) {
+        NSImage *image = [[NSImage alloc] initWithData:imageData];
+        NSImageView *imageView = [NSImageView imageViewWithImage:image];
+        [imageView setImageScaling:NSImageScaleProportionallyUpOrDown];
+        [imageView setFrame:frame];
+        [splash setContentView:imageView];
+    } else if ( html != nil && [html length] > 0 ) {
+        WKWebView *webview = [[WKWebView alloc] initWithFrame:frame];
+        [webview loadHTMLString:html baseURL:nil];
+        [splash setContentView:webview];
+    }
+
+    [splash center];
+    [splash makeKeyAndOrderFront:nil];
+    self.splashWindow = splash;
+}
+
+- (void) HideSplashScreen {
+    if ( self.splashWindow == nil ) {
+        return;
+    }
+    [self.splashWindow orderOut:nil];
+    [self.splashWindow close];
+    self.splashWindow = nil;
+}
+
 - (void) SetAbout :(NSString*)title :(NSString*)description :(void*)imagedata :(int)datalen {
     self.aboutTitle = title;
     self.aboutDescription = description;
@@ -0,0 +1,24 @@
+//
+//  WailsDownload.h
+//
+
+#ifndef WailsDownload_h
+#define WailsDownload_h
+
+#import <Cocoa/Cocoa.h>
+#import <WebKit/WebKit.h>
+
+// WailsDownloadDelegate tracks the downloads started by the webview. The destination of each download
+// is decided by the Go side, which is asked with processDownloadRequest.
+@interface WailsDownloadDelegate : NSObject
+
+@property int nextID;
+@property (retain) NSMutableDictionary* downloads;
+@property (retain) NSMutableDictionary* destinationHandlers;
+
+- (void) track:(id)download;
+- (void) SetDestination:(int)downloadID :(NSString*)path;
+
+@end
+
+#endif /* WailsDownload_h */
@@ -0,0 +1,114 @@
+//go:build darwin
+//
+//  WailsDownload.m
+//
+
+#import <Foundation/Foundation.h>
+#import <WebKit/WebKit.h>
+
+#import "WailsDownload.h"
+#import "message.h"
+
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+@interface WailsDownloadDelegate () <WKDownloadDelegate>
+@end
+#endif
+
+@implementation WailsDownloadDelegate
+
+- (instancetype) init {
+    self = [super init];
+    if (self) {
+        self.downloads = [NSMutableDictionary dictionary];
+        self.destinationHandlers = [NSMutableDictionary dictionary];
+    }
+    return self;
+}
+
+- (NSNumber*) idForDownload:(id)download {
+    return [[self.downloads allKeysForObject:download] firstObject];
+}
+
+- (void) track:(id)download {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+    if (@available(macOS 11.3, *)) {
+        WKDownload *_download = download;
+        _download.delegate = self;
+    }
+#endif
+}
+
+- (void) SetDestination:(int)downloadID :(NSString*)path {
+    NSNumber *key = [NSNumber numberWithInt:downloadID];
+    void (^completionHandler)(NSURL *) = self.destinationHandlers[key];
+    if (completionHandler == nil) {
+        return;
+    }
+    [completionHandler retain];
+    [self.destinationHandlers removeObjectForKey:key];
+
+    if ( path == nil || [path length] == 0 ) {
+        // The download has been declined
+        completionHandler(nil);
+        [completionHandler release];
+        [self.downloads removeObjectForKey:key];
+        return;
+    }
+
+    id download = self.downloads[key];
+    [[download progress] addObserver:self forKeyPath:@"completedUnitCount" options:0 context:nil];
+    completionHandler([NSURL fileURLWithPath:path]);
+    [completionHandler release];
+}
+
+- (void) stopTracking:(NSNumber*)key :(NSString*)error {
+    id download = self.downloads[key];
+    if (download == nil) {
+        return;
+    }
+    if (self.destinationHandlers[key] == nil) {
+        // Only downloads with a destination are observed
+        [[download progress] removeObserver:self forKeyPath:@"completedUnitCount"];
+    }
+    [self.destinationHandlers removeObjectForKey:key];
+    [self.downloads removeObjectForKey:key];
+    processDownloadFinished([key intValue], [error UTF8String]);
+}
+
+- (void) observeValueForKeyPath:(NSString *)keyPath ofObject:(id)object change:(NSDictionary *)change context:(void *)context {
+    NSProgress *progress = object;
+    for (NSNumber *key in self.downloads) {
+        if ([self.downloads[key] progress] == progress) {
+            processDownloadProgress([key intValue], progress.completedUnitCount, progress.totalUnitCount);
+            return;
+        }
+    }
+}
+
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 110300
+- (void)download:(WKDownload *)download decideDestinationUsingResponse:(NSURLResponse *)response suggestedFilename:(NSString *)suggestedFilename completionHandler:(void (^)(NSURL *))completionHandler API_AVAILABLE(macos(11.3)) {
+    self.nextID++;
+    NSNumber *key = [NSNumber numberWithInt:self.nextID];
+    self.downloads[key] = download;
+    self.destinationHandlers[key] = [[completionHandler copy] autorelease];
+
+    NSString *url = [[[download originalRequest] URL] absoluteString];
+    processDownloadRequest(self.nextID, [url UTF8String], [suggestedFilename UTF8String]);
+}
+
+- (void)downloadDidFinish:(WKDownload *)download API_AVAILABLE(macos(11.3)) {
+    NSNumber *key = [self idForDownload:download];
+    if (key != nil) {
+        [self stopTracking:key :@""];
+    }
+}
+
+- (void)download:(WKDownload *)download didFailWithError:(NSError *)error resumeData:(NSData *)resumeData API_AVAILABLE(macos(11.3)) {
+    NSNumber *key = [self idForDownload:download];
+    if (key != nil) {
+        [self stopTracking:key :[error localizedDescription]];
+    }
+}
+#endif
+
+@end
@@ -0,0 +1,11 @@
+//
+//  WailsNetwork.h
+//
+
+#ifndef WailsNetwork_h
+#define WailsNetwork_h
+
+// StartNetworkMonitor reports the network status with processNetworkStatus, when it's known and on each change
+void StartNetworkMonitor(void);
+
+#endif /* WailsNetwork_h */
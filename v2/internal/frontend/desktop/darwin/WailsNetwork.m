@@ -0,0 +1,30 @@
+//go:build darwin
+//
+//  WailsNetwork.m
+//
+
+#import <Foundation/Foundation.h>
+#import <Network/Network.h>
+
+#import "WailsNetwork.h"
+#import "message.h"
+
+static nw_path_monitor_t networkMonitor = nil;
+
+void StartNetworkMonitor(void) {
+    if (networkMonitor != nil) {
+        return;
+    }
+    networkMonitor = nw_path_monitor_create();
+    dispatch_queue_t queue = dispatch_queue_create("wails.network", DISPATCH_QUEUE_SERIAL);
+    nw_path_monitor_set_queue(networkMonitor, queue);
+    nw_path_monitor_set_update_handler(networkMonitor, ^(nw_path_t path) {
+        bool online = nw_path_get_status(path) == nw_path_status_satisfied;
+        bool constrained = false;
+        if (@available(macOS 10.15, *)) {
+            constrained = nw_path_is_constrained(path);
+        }
+        processNetworkStatus(online, nw_path_is_expensive(path), constrained);
+    });
+    nw_path_monitor_start(networkMonitor);
+}
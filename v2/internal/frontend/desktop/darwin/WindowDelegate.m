@@ -22,12 +22,52 @@
     return false;
 }
 
+- (void)windowDidEnterFullScreen:(NSNotification *)notification {
+    processMessage("wails:window:fullscreen");
+}
+
 - (void)windowDidExitFullScreen:(NSNotification *)notification {
     [self.ctx.mainWindow applyWindowConstraints];
+    processMessage("wails:window:unfullscreen");
 }
 
 - (void)windowWillEnterFullScreen:(NSNotification *)notification {
     [self.ctx.mainWindow disableWindowConstraints];
+    if (![self.ctx.mainWindow isZoomed]) {
+        self.ctx.normalFrame = [self.ctx.mainWindow frame];
+    }
+}
+
+- (BOOL)windowShouldZoom:(NSWindow *)window toFrame:(NSRect)newFrame {
+    if (self.ctx.maximizeDisabled) {
+        return NO;
+    }
+    // Remember the frame to restore, it's saved with the window state
+    if (![window isZoomed]) {
+        self.ctx.normalFrame = [window frame];
+    }
+    return YES;
+}
+
+- (void)windowDidBecomeKey:(NSNotification *)notification {
+    [self.ctx CancelUserAttentionRequest];
+    processMessage("wails:window:active");
+}
+
+- (void)windowDidResignKey:(NSNotification *)notification {
+    processMessage("wails:window:inactive");
+}
+
+- (void)windowDidMiniaturize:(NSNotification *)notification {
+    processMessage("wails:window:minimise");
+}
+
+- (void)windowDidDeminiaturize:(NSNotification *)notification {
+    processMessage("wails:window:restore");
+}
+
+- (void)windowDidChangeScreen:(NSNotification *)notification {
+    processMessage("wails:screen:changed");
 }
 
 - (NSApplicationPresentationOptions)window:(WailsWindow *)window willUseFullScreenPresentationOptions:(NSApplicationPresentationOptions)proposedOptions {
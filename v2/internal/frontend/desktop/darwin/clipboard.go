@@ -2,36 +2,218 @@
 
 package darwin
 
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa
+#import <Foundation/Foundation.h>
+#import <Cocoa/Cocoa.h>
+
+#include <stdbool.h>
+#include <stdlib.h>
+#include <string.h>
+
+long GetClipboardChangeCount(void) {
+	return [[NSPasteboard generalPasteboard] changeCount];
+}
+
+bool IsApplicationActive(void) {
+	return [NSApp isActive];
+}
+
+// GetClipboardText returns a copy of the text on the pasteboard as UTF-8, which must be freed, or NULL if there is none
+char* GetClipboardText(int *length) {
+	char *result = NULL;
+	@autoreleasepool {
+		NSString *text = [[NSPasteboard generalPasteboard] stringForType:NSPasteboardTypeString];
+		NSData *data = [text dataUsingEncoding:NSUTF8StringEncoding];
+		if (data != nil) {
+			*length = (int)[data length];
+			result = malloc(*length + 1);
+			memcpy(result, [data bytes], *length);
+		}
+	}
+	return result;
+}
+
+// SetClipboardText replaces the content of the pasteboard with the UTF-8 text
+bool SetClipboardText(const char *bytes, int length) {
+	bool result = false;
+	@autoreleasepool {
+		NSString *text = [[[NSString alloc] initWithBytes:bytes length:length encoding:NSUTF8StringEncoding] autorelease];
+		if (text != nil) {
+			NSPasteboard *pasteboard = [NSPasteboard generalPasteboard];
+			[pasteboard clearContents];
+			result = [pasteboard setString:text forType:NSPasteboardTypeString];
+		}
+	}
+	return result;
+}
+
+// GetClipboardImage returns a copy of the PNG or TIFF image on the pasteboard, which must be freed.
+// The type is set to 1 for PNG and 2 for TIFF
+void* GetClipboardImage(int *length, int *type) {
+	void *result = NULL;
+	@autoreleasepool {
+		NSPasteboard *pasteboard = [NSPasteboard generalPasteboard];
+		NSData *data = [pasteboard dataForType:NSPasteboardTypePNG];
+		*type = 1;
+		if (data == nil) {
+			data = [pasteboard dataForType:NSPasteboardTypeTIFF];
+			*type = 2;
+		}
+		if (data == nil || [data length] == 0) {
+			*type = 0;
+			*length = 0;
+		} else {
+			*length = (int)[data length];
+			result = malloc(*length);
+			memcpy(result, [data bytes], *length);
+		}
+	}
+	return result;
+}
+
+// SetClipboardImage writes the image to the pasteboard. PNG (type 1) and TIFF (type 2) are written as they are,
+// other formats are converted by NSImage. Returns false if the data isn't an image
+bool SetClipboardImage(void *bytes, int length, int type) {
+	bool result = false;
+	@autoreleasepool {
+		NSData *data = [NSData dataWithBytes:bytes length:length];
+		NSPasteboard *pasteboard = [NSPasteboard generalPasteboard];
+		if (type == 1 || type == 2) {
+			[pasteboard clearContents];
+			result = [pasteboard setData:data forType:(type == 1 ? NSPasteboardTypePNG : NSPasteboardTypeTIFF)];
+		} else {
+			NSImage *image = [[[NSImage alloc] initWithData:data] autorelease];
+			if (image != nil) {
+				[pasteboard clearContents];
+				result = [pasteboard writeObjects:@[image]];
+			}
+		}
+	}
+	return result;
+}
+*/
+import "C"
+
 import (
-	"os/exec"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+	"unicode/utf8"
+	"unsafe"
 
-	"github.com/wailsapp/wails/v2/internal/conv"
+	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
 func (f *Frontend) ClipboardGetText() (string, error) {
-	pasteCmd := exec.Command("pbpaste")
-	out, err := pasteCmd.Output()
-	if err != nil {
-		return "", err
+	var length C.int
+	text := C.GetClipboardText(&length)
+	if text == nil {
+		return "", nil
 	}
-	return conv.BytesToString(out), nil
+	defer C.free(unsafe.Pointer(text))
+	return C.GoStringN(text, length), nil
 }
 
 func (f *Frontend) ClipboardSetText(text string) error {
-	copyCmd := exec.Command("pbcopy")
-	in, err := copyCmd.StdinPipe()
-	if err != nil {
-		return err
+	if !utf8.ValidString(text) {
+		return errors.New("the text is not valid UTF-8")
 	}
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	if !C.SetClipboardText(ctext, C.int(len(text))) {
+		return errors.New("unable to write the text to the clipboard")
+	}
+	return nil
+}
+
+// clipboardWatcher emits "wails:clipboard:changed" when the change count of the pasteboard changes
+type clipboardWatcher struct {
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (f *Frontend) startClipboardWatcher() {
+	watcher := &clipboardWatcher{stop: make(chan struct{})}
+	f.clipboardWatcher = watcher
+
+	go func() {
+		ticker := time.NewTicker(500 * time.Millisecond)
+		defer ticker.Stop()
+		changeCount := C.GetClipboardChangeCount()
+		for {
+			select {
+			case <-watcher.stop:
+				return
+			case <-ticker.C:
+			}
+			if !C.IsApplicationActive() {
+				continue
+			}
+			current := C.GetClipboardChangeCount()
+			if current == changeCount {
+				continue
+			}
+			changeCount = current
+			text, err := f.ClipboardGetText()
+			if err != nil {
+				f.logger.Error("Unable to read the clipboard: %s", err.Error())
+				continue
+			}
+			f.emit("wails:clipboard:changed", text)
+		}
+	}()
+}
+
+func (f *Frontend) stopClipboardWatcher() {
+	if f.clipboardWatcher == nil {
+		return
+	}
+	f.clipboardWatcher.stopOnce.Do(func() {
+		close(f.clipboardWatcher.stop)
+	})
+}
+
+// ClipboardGetImage returns the image on the clipboard and its MIME type, "image/png" or "image/tiff".
+// frontend.ErrClipboardEmpty is returned if there is no image
+func (f *Frontend) ClipboardGetImage() ([]byte, string, error) {
+	var length, imageType C.int
+	bytes := C.GetClipboardImage(&length, &imageType)
+	if bytes == nil {
+		return nil, "", frontend.ErrClipboardEmpty
+	}
+	defer C.free(bytes)
 
-	if err := copyCmd.Start(); err != nil {
-		return err
+	mime := "image/png"
+	if imageType == 2 {
+		mime = "image/tiff"
 	}
-	if _, err := in.Write([]byte(text)); err != nil {
-		return err
+	return C.GoBytes(bytes, length), mime, nil
+}
+
+// ClipboardSetImage writes the image to the clipboard. If mime is empty it's detected from the data
+func (f *Frontend) ClipboardSetImage(data []byte, mime string) error {
+	if len(data) == 0 {
+		return errors.New("no image data")
 	}
-	if err := in.Close(); err != nil {
-		return err
+	if mime == "" {
+		mime = http.DetectContentType(data)
+	}
+
+	var imageType C.int
+	switch mime {
+	case "image/png":
+		imageType = 1
+	case "image/tiff":
+		imageType = 2
+	}
+
+	bytes := C.CBytes(data)
+	defer C.free(bytes)
+	if !C.SetClipboardImage(bytes, C.int(len(data)), imageType) {
+		return errors.New("unable to write the image of type '" + mime + "' to the clipboard")
 	}
-	return copyCmd.Wait()
+	return nil
 }
@@ -0,0 +1,86 @@
+//go:build darwin
+
+package darwin
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pbpasteGetText and pbcopySetText are the previous implementation, used to compare the performance
+func pbpasteGetText() (string, error) {
+	out, err := exec.Command("pbpaste").Output()
+	return string(out), err
+}
+
+func pbcopySetText(text string) error {
+	copyCmd := exec.Command("pbcopy")
+	copyCmd.Stdin = strings.NewReader(text)
+	return copyCmd.Run()
+}
+
+func keepClipboardText(t testing.TB, f *Frontend) {
+	previous, err := f.ClipboardGetText()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = f.ClipboardSetText(previous)
+	})
+}
+
+func TestClipboardText(t *testing.T) {
+	f := &Frontend{}
+	keepClipboardText(t, f)
+
+	tests := []string{
+		"hello",
+		"",
+		"multiple\nlines\r\nof text\n",
+		"Grüße, ¿qué tal? Ça va",
+		"日本語のテキスト",
+		"emoji 🎉👩‍👩‍👧‍👦🏳️‍🌈",
+		"nul\x00byte",
+	}
+	for _, text := range tests {
+		require.NoError(t, f.ClipboardSetText(text))
+		got, err := f.ClipboardGetText()
+		require.NoError(t, err)
+		require.Equal(t, text, got)
+	}
+
+	// The text must be readable by other applications
+	require.NoError(t, f.ClipboardSetText("emoji 🎉"))
+	got, err := pbpasteGetText()
+	require.NoError(t, err)
+	require.Equal(t, "emoji 🎉", got)
+
+	require.Error(t, f.ClipboardSetText("invalid \xff UTF-8"))
+}
+
+func BenchmarkClipboardTextNSPasteboard(b *testing.B) {
+	f := &Frontend{}
+	keepClipboardText(b, f)
+	for i := 0; i < b.N; i++ {
+		if err := f.ClipboardSetText("benchmark 🎉"); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := f.ClipboardGetText(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClipboardTextPbcopy(b *testing.B) {
+	f := &Frontend{}
+	keepClipboardText(b, f)
+	for i := 0; i < b.N; i++ {
+		if err := pbcopySetText("benchmark 🎉"); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := pbpasteGetText(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
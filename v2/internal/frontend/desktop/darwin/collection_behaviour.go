@@ -0,0 +1,47 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// collectionBehaviours maps the flag names to their NSWindowCollectionBehavior values
+var collectionBehaviours = map[string]uint{
+	"default":                   0,
+	"canJoinAllSpaces":          1 << 0,
+	"moveToActiveSpace":         1 << 1,
+	"managed":                   1 << 2,
+	"transient":                 1 << 3,
+	"stationary":                1 << 4,
+	"participatesInCycle":       1 << 5,
+	"ignoresCycle":              1 << 6,
+	"fullScreenPrimary":         1 << 7,
+	"fullScreenAuxiliary":       1 << 8,
+	"fullScreenNone":            1 << 9,
+	"fullScreenAllowsTiling":    1 << 11,
+	"fullScreenDisallowsTiling": 1 << 12,
+	"primary":                   1 << 16,
+	"auxiliary":                 1 << 17,
+	"canJoinAllApplications":    1 << 18,
+}
+
+func collectionBehaviour(flags []string) (uint, error) {
+	var result uint
+	for _, flag := range flags {
+		value, ok := collectionBehaviours[flag]
+		if !ok {
+			valid := make([]string, 0, len(collectionBehaviours))
+			for name := range collectionBehaviours {
+				valid = append(valid, name)
+			}
+			sort.Strings(valid)
+			return 0, fmt.Errorf("unknown collection behaviour '%s'. Valid values: %s", flag, strings.Join(valid, ", "))
+		}
+		result |= value
+	}
+	return result, nil
+}
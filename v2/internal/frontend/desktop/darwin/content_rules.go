@@ -0,0 +1,52 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+// Obj-C sends the result of compiling the content rules to this channel
+var (
+	contentRulesResponse = make(chan string)
+	contentRulesLock     sync.Mutex
+)
+
+// SetContentRules compiles the given WebKit content-blocker rules and applies them to the webview,
+// replacing any rules set previously
+func (f *Frontend) SetContentRules(rules string) error {
+	contentRulesLock.Lock()
+	defer contentRulesLock.Unlock()
+
+	_rules := C.CString(rules)
+	C.SetContentRules(f.mainWindow.context, _rules)
+	C.free(unsafe.Pointer(_rules))
+
+	if errMessage := <-contentRulesResponse; errMessage != "" {
+		return errors.New("unable to compile content rules: " + errMessage)
+	}
+	return nil
+}
+
+// ClearContentRules removes all content rules from the webview
+func (f *Frontend) ClearContentRules() {
+	C.ClearContentRules(f.mainWindow.context)
+}
+
+//export processContentRulesResponse
+func processContentRulesResponse(cerror *C.char) {
+	contentRulesResponse <- C.GoString(cerror)
+}
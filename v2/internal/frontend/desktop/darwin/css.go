@@ -0,0 +1,25 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// InjectCSS adds or replaces the stylesheet with the given id. It's registered as a user script,
+// so it's applied at document start on every page loaded afterwards
+func (f *Frontend) InjectCSS(id string, css string) {
+	js := frontend.InjectCSSJS(id, css)
+	// User scripts can't be removed individually, so every change is added as another user script.
+	// They run in order, leaving each page with the latest stylesheets
+	f.mainWindow.AddUserScript(js, true)
+	f.ExecJS(js)
+}
+
+// RemoveCSS removes the stylesheet with the given id
+func (f *Frontend) RemoveCSS(id string) {
+	js := frontend.RemoveCSSJS(id)
+	f.mainWindow.AddUserScript(js, true)
+	f.ExecJS(js)
+}
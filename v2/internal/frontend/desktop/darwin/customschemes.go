@@ -0,0 +1,74 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/assetserver"
+	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// See RFC 3986, Section 3.1
+var schemeNameRegex = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+
+// reservedSchemes can't be registered, WebKit raises an exception for the schemes it handles natively
+var reservedSchemes = map[string]bool{
+	"wails": true, "http": true, "https": true, "file": true, "ftp": true, "data": true,
+	"about": true, "blob": true, "javascript": true, "ws": true, "wss": true,
+}
+
+// customSchemeHandlers returns the handlers of the custom schemes in the options by lowercase scheme name
+func customSchemeHandlers(appoptions *options.App) (map[string]http.Handler, error) {
+	handlers := map[string]http.Handler{}
+	for _, customScheme := range appoptions.CustomSchemes {
+		scheme := strings.ToLower(strings.TrimSuffix(customScheme.Scheme, "://"))
+		switch {
+		case !schemeNameRegex.MatchString(scheme):
+			return nil, fmt.Errorf("invalid custom scheme '%s'", customScheme.Scheme)
+		case reservedSchemes[scheme]:
+			return nil, fmt.Errorf("custom scheme '%s' is reserved", customScheme.Scheme)
+		case customScheme.Handler == nil:
+			return nil, fmt.Errorf("custom scheme '%s' has no handler", customScheme.Scheme)
+		case handlers[scheme] != nil:
+			return nil, fmt.Errorf("custom scheme '%s' is registered more than once", customScheme.Scheme)
+		}
+		handlers[scheme] = customScheme.Handler
+	}
+	return handlers, nil
+}
+
+// customSchemeNames returns the comma separated list of the custom schemes to register with the webview
+func customSchemeNames(handlers map[string]http.Handler) string {
+	names := make([]string, 0, len(handlers))
+	for scheme := range handlers {
+		names = append(names, scheme)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// serveCustomSchemeRequest serves the request if it's on a custom scheme and returns true if it has been handled
+func (f *Frontend) serveCustomSchemeRequest(request webview.Request) bool {
+	uri, err := request.URL()
+	if err != nil {
+		return false
+	}
+	requestURL, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	handler := f.customSchemes[strings.ToLower(requestURL.Scheme)]
+	if handler == nil {
+		return false
+	}
+	assetserver.ServeWebViewRequestWithHandler(request, handler, f.logger)
+	return true
+}
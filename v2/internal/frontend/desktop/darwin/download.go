@@ -0,0 +1,192 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// downloadProgressInterval limits how often the "wails:download:progress" event is emitted for a download
+const downloadProgressInterval = 100 * time.Millisecond
+
+// DownloadEvent is emitted with the "wails:download:*" events
+type DownloadEvent struct {
+	ID       int    `json:"id"`
+	URL      string `json:"url"`
+	Path     string `json:"path"`
+	Received int64  `json:"received"`
+	Total    int64  `json:"total"`
+	Error    string `json:"error,omitempty"`
+}
+
+type downloadMessageKind int
+
+const (
+	downloadRequested downloadMessageKind = iota
+	downloadProgress
+	downloadFinished
+)
+
+// downloadMessage is sent by Obj-C for each change of a download
+type downloadMessage struct {
+	kind          downloadMessageKind
+	id            int
+	url           string
+	suggestedName string
+	received      int64
+	total         int64
+	err           string
+}
+
+var downloadBuffer = make(chan downloadMessage, 100)
+
+// The downloads that have a destination
+var (
+	downloadsLock sync.Mutex
+	downloads     = map[int]*downloadState{}
+)
+
+type downloadState struct {
+	event        DownloadEvent
+	lastProgress time.Time
+}
+
+func (f *Frontend) startDownloadProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "download processor")
+	for message := range downloadBuffer {
+		switch message.kind {
+		case downloadRequested:
+			// The callback might block, e.g. while showing a save dialog
+			go f.decideDownloadDestination(message.id, message.url, message.suggestedName)
+		case downloadProgress:
+			f.downloadProgress(message.id, message.received, message.total)
+		case downloadFinished:
+			f.downloadFinished(message.id, message.err)
+		}
+	}
+}
+
+func (f *Frontend) decideDownloadDestination(id int, url string, suggestedName string) {
+	var path string
+	var accept bool
+	if f.frontendOptions.OnDownload != nil {
+		path, accept = f.frontendOptions.OnDownload(url, suggestedName)
+	} else {
+		var err error
+		path, err = defaultDownloadPath(suggestedName)
+		if err != nil {
+			f.logger.Error("Unable to download '%s': %s", url, err)
+		}
+		accept = err == nil
+	}
+	if !accept {
+		path = ""
+	}
+
+	if path != "" {
+		event := DownloadEvent{ID: id, URL: url, Path: path, Total: -1}
+		downloadsLock.Lock()
+		downloads[id] = &downloadState{event: event}
+		downloadsLock.Unlock()
+		f.emit("wails:download:started", event)
+	}
+
+	// An empty path declines the download
+	_path := C.CString(path)
+	C.SetDownloadDestination(f.mainWindow.context, C.int(id), _path)
+	C.free(unsafe.Pointer(_path))
+}
+
+func (f *Frontend) downloadProgress(id int, received int64, total int64) {
+	downloadsLock.Lock()
+	state := downloads[id]
+	if state == nil || time.Since(state.lastProgress) < downloadProgressInterval {
+		downloadsLock.Unlock()
+		return
+	}
+	state.lastProgress = time.Now()
+	state.event.Received = received
+	state.event.Total = total
+	event := state.event
+	downloadsLock.Unlock()
+
+	f.emit("wails:download:progress", event)
+}
+
+func (f *Frontend) downloadFinished(id int, errMessage string) {
+	downloadsLock.Lock()
+	state := downloads[id]
+	delete(downloads, id)
+	downloadsLock.Unlock()
+	if state == nil {
+		return
+	}
+
+	event := state.event
+	if errMessage != "" {
+		event.Error = errMessage
+		f.emit("wails:download:failed", event)
+		return
+	}
+	if info, err := os.Stat(event.Path); err == nil {
+		event.Received = info.Size()
+		event.Total = info.Size()
+	}
+	f.emit("wails:download:finished", event)
+}
+
+// defaultDownloadPath returns a path in the Downloads folder for the suggested name that doesn't exist yet
+func defaultDownloadPath(suggestedName string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Base(suggestedName)
+	if name == "." || name == string(filepath.Separator) {
+		name = "download"
+	}
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	path := filepath.Join(home, "Downloads", name)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return path, nil
+		}
+		path = filepath.Join(home, "Downloads", fmt.Sprintf("%s (%d)%s", base, i, ext))
+	}
+}
+
+//export processDownloadRequest
+func processDownloadRequest(id C.int, url *C.char, suggestedName *C.char) {
+	downloadBuffer <- downloadMessage{kind: downloadRequested, id: int(id), url: C.GoString(url), suggestedName: C.GoString(suggestedName)}
+}
+
+//export processDownloadProgress
+func processDownloadProgress(id C.int, received C.longlong, total C.longlong) {
+	downloadBuffer <- downloadMessage{kind: downloadProgress, id: int(id), received: int64(received), total: int64(total)}
+}
+
+//export processDownloadFinished
+func processDownloadFinished(id C.int, cerror *C.char) {
+	downloadBuffer <- downloadMessage{kind: downloadFinished, id: int(id), err: C.GoString(cerror)}
+}
@@ -0,0 +1,103 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// The bound method calling StartDrag arrives asynchronously, so the mouse button may already have been released
+var errNoMouseButtonPressed = errors.New("no mouse button pressed, dragging can only start while it's held down")
+
+// StartDrag starts dragging the given items out of the application. It has to be called while
+// the mouse button is pressed, EG: from a bound method called in a "mousedown" handler
+func (f *Frontend) StartDrag(items []frontend.DragItem) error {
+	if len(items) == 0 {
+		return errors.New("no items to drag")
+	}
+	if !bool(C.IsMouseButtonPressed(f.mainWindow.context)) {
+		return errNoMouseButtonPressed
+	}
+
+	// The directories of this call are removed straight away if the drag doesn't start
+	var dirs []string
+	removeDirs := func() {
+		for _, dir := range dirs {
+			_ = os.RemoveAll(dir)
+		}
+	}
+	paths := make([]string, 0, len(items))
+	for _, item := range items {
+		path := item.Path
+		if path == "" {
+			if item.Name == "" {
+				removeDirs()
+				return errors.New("drag item requires a Path or a Name")
+			}
+			dir, err := os.MkdirTemp("", "wails-drag-*")
+			if err != nil {
+				removeDirs()
+				return fmt.Errorf("unable to create drag item %q: %w", item.Name, err)
+			}
+			dirs = append(dirs, dir)
+			path = filepath.Join(dir, filepath.Base(item.Name))
+			if err := os.WriteFile(path, item.Data, 0o644); err != nil {
+				removeDirs()
+				return fmt.Errorf("unable to create drag item %q: %w", item.Name, err)
+			}
+		}
+		paths = append(paths, path)
+	}
+
+	data, err := json.Marshal(paths)
+	if err != nil {
+		removeDirs()
+		return err
+	}
+	_paths := C.CString(string(data))
+	started := bool(C.StartDrag(f.mainWindow.context, _paths))
+	C.free(unsafe.Pointer(_paths))
+	if !started {
+		// The mouse button has been released in the meantime
+		removeDirs()
+		return errNoMouseButtonPressed
+	}
+
+	f.dragDirsLock.Lock()
+	f.dragDirs = append(f.dragDirs, dirs...)
+	f.dragDirsLock.Unlock()
+	return nil
+}
+
+// removeDragDirs removes the files created for dragged items. This isn't done when the drag session ends,
+// because the drop target may still be copying them
+func (f *Frontend) removeDragDirs() {
+	f.dragDirsLock.Lock()
+	dirs := f.dragDirs
+	f.dragDirs = nil
+	f.dragDirsLock.Unlock()
+
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			f.logger.Error("Unable to remove drag item directory '%s': %s", dir, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+type execJSResult struct {
+	json string
+	err  error
+}
+
+// Obj-C sends the results of evaluated scripts to the channel registered for the callback ID
+var (
+	execJSCallbacks     = make(map[int]chan execJSResult)
+	execJSCallbacksLock sync.Mutex
+	execJSCallbackID    int
+)
+
+// execJSWithResult evaluates the given JS expression and returns its value JSON encoded
+func (f *Frontend) execJSWithResult(expression string) (string, error) {
+	result := make(chan execJSResult, 1)
+
+	execJSCallbacksLock.Lock()
+	execJSCallbackID++
+	callbackID := execJSCallbackID
+	execJSCallbacks[callbackID] = result
+	execJSCallbacksLock.Unlock()
+
+	_js := C.CString("JSON.stringify(" + expression + ")")
+	C.ExecJSWithResult(f.mainWindow.context, _js, C.int(callbackID))
+	C.free(unsafe.Pointer(_js))
+
+	r := <-result
+	return r.json, r.err
+}
+
+//export processExecJSResult
+func processExecJSResult(callbackID C.int, cresult *C.char, cerror *C.char) {
+	execJSCallbacksLock.Lock()
+	result, ok := execJSCallbacks[int(callbackID)]
+	delete(execJSCallbacks, int(callbackID))
+	execJSCallbacksLock.Unlock()
+	if !ok {
+		return
+	}
+
+	if cerror != nil {
+		result <- execJSResult{err: errors.New(C.GoString(cerror))}
+		return
+	}
+	result <- execJSResult{json: C.GoString(cresult)}
+}
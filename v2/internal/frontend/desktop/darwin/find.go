@@ -0,0 +1,119 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// countMatchesJS counts the occurrences of the query in the visible text of the page
+const countMatchesJS = `(function(query, caseSensitive) {
+    var text = document.body ? document.body.innerText : "";
+    if (!caseSensitive) {
+        text = text.toLowerCase();
+        query = query.toLowerCase();
+    }
+    var count = 0;
+    var index = text.indexOf(query);
+    while (query.length > 0 && index !== -1) {
+        count++;
+        index = text.indexOf(query, index + query.length);
+    }
+    return count;
+})(%s, %t)`
+
+// The current find session
+var (
+	findLock    sync.Mutex
+	findQuery   string
+	findOptions frontend.FindOptions
+	findIndex   int
+)
+
+// FindResult is emitted with the "wails:find:result" event after each search
+type FindResult struct {
+	Query   string `json:"query"`
+	Current int    `json:"current"`
+	Total   int    `json:"total"`
+}
+
+// FindInPage highlights the next match of the query and returns the total number of matches.
+// Calling it again with the same query moves to the next match.
+func (f *Frontend) FindInPage(query string, options frontend.FindOptions) (int, error) {
+	if !bool(C.SupportsFindInPage()) {
+		return 0, errors.New("find in page requires macOS 11 or later")
+	}
+
+	findLock.Lock()
+	defer findLock.Unlock()
+
+	encodedQuery, err := json.Marshal(query)
+	if err != nil {
+		return 0, err
+	}
+	result, err := f.execJSWithResult(fmt.Sprintf(countMatchesJS, encodedQuery, options.CaseSensitive))
+	if err != nil {
+		return 0, fmt.Errorf("unable to count matches: %w", err)
+	}
+	total, err := strconv.Atoi(result)
+	if err != nil {
+		return 0, fmt.Errorf("unable to count matches: %w", err)
+	}
+
+	switch {
+	case total == 0:
+		findIndex = 0
+	case query != findQuery || options.CaseSensitive != findOptions.CaseSensitive:
+		findIndex = 1
+		if options.Backwards {
+			findIndex = total
+		}
+	case options.Backwards:
+		findIndex = (findIndex+total-2)%total + 1
+	default:
+		findIndex = findIndex%total + 1
+	}
+	findQuery = query
+	findOptions = options
+
+	if total > 0 {
+		_query := C.CString(query)
+		C.FindInPage(f.mainWindow.context, _query, bool2Cint(options.CaseSensitive), bool2Cint(options.Backwards))
+		C.free(unsafe.Pointer(_query))
+	}
+
+	f.emit("wails:find:result", FindResult{
+		Query:   query,
+		Current: findIndex,
+		Total:   total,
+	})
+	return total, nil
+}
+
+// FindStopSession ends the current find session and clears the highlighted match
+func (f *Frontend) FindStopSession() {
+	findLock.Lock()
+	defer findLock.Unlock()
+
+	findQuery = ""
+	findIndex = 0
+	f.ExecJS("window.getSelection().removeAllRanges();")
+}
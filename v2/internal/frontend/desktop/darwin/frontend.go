@@ -16,14 +16,20 @@ package darwin
 import "C"
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"html/template"
+	"image/png"
 	"log"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/internal/conv"
@@ -36,6 +42,7 @@ import (
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/mac"
 )
 
 const startURL = "wails://wails/"
@@ -72,19 +79,67 @@ type Frontend struct {
 	assets   *assetserver.AssetServer
 	startURL *url.URL
 
+	// Handlers of the custom schemes by scheme name
+	customSchemes map[string]http.Handler
+
 	// main window handle
 	mainWindow *Window
 	bindings   *binding.Bindings
 	dispatcher frontend.Dispatcher
 
 	originValidator *originvalidator.OriginValidator
+
+	hideSplashScreenOnce sync.Once
+
+	// Guards the document state below, which is restored when the page reloads
+	documentStateLock sync.Mutex
+
+	// nil uses the system setting
+	spellCheckEnabled *bool
+
+	// Selector of the element highlighted by HighlightElement, restored on reload
+	highlightSelector string
+
+	idleMonitor *frontend.IdleMonitor
+
+	// Set by QuitWithCode
+	exitCode atomic.Int32
+
+	// Set when Mac.ClipboardChangeEvents is enabled
+	clipboardWatcher *clipboardWatcher
+
+	// Throttling state of NotifyThrottled
+	notifyThrottle frontend.NotifyThrottle
+	execJSRecorder frontend.ExecJSRecorder
+
+	// Stopped when the runtime of the frontend is ready
+	startupWatchdog *frontend.StartupWatchdog
+
+	// Logs the time until the frontend is ready in dev mode
+	startupTimer *frontend.StartupTimer
+
+	// Whether the window isn't the key window, restored in the document after reloads
+	windowInactive bool
+
+	// Temporary directories of the items created by StartDrag, removed when the window closes
+	dragDirsLock sync.Mutex
+	dragDirs     []string
+
+	// Size constraints relative to the current screen
+	sizeFractionLock sync.Mutex
+	minSizeFraction  sizeFraction
+	maxSizeFraction  sizeFraction
 }
 
 func (f *Frontend) RunMainLoop() {
+	mainLoopWatchdog := frontend.StartMainLoopWatchdog(f.debug, f.frontendOptions, f.logger, dispatchOnMainThread)
+	defer mainLoopWatchdog.Stop()
 	C.RunMainLoop()
 }
 
 func (f *Frontend) WindowClose() {
+	f.stopClipboardWatcher()
+	f.removeDragDirs()
 	C.ReleaseContext(f.mainWindow.context)
 }
 
@@ -95,6 +150,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		bindings:        appBindings,
 		dispatcher:      dispatcher,
 		ctx:             ctx,
+		idleMonitor:     frontend.NewIdleMonitor(ctx),
 	}
 	result.startURL, _ = url.Parse(startURL)
 	result.originValidator = originvalidator.NewOriginValidator(result.startURL, appoptions.BindingsAllowedOrigins)
@@ -102,6 +158,12 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	// this should be initialized as early as possible to handle first instance launch
 	C.StartCustomProtocolHandler()
 
+	customSchemes, err := customSchemeHandlers(appoptions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	result.customSchemes = customSchemes
+
 	if _starturl, _ := ctx.Value("starturl").(*url.URL); _starturl != nil {
 		result.startURL = _starturl
 		result.originValidator = originvalidator.NewOriginValidator(result.startURL, appoptions.BindingsAllowedOrigins)
@@ -128,7 +190,9 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		}
 		assets.ExpectedWebViewHost = result.startURL.Host
 		result.assets = assets
+	}
 
+	if result.assets != nil || len(result.customSchemes) > 0 {
 		go result.startRequestProcessor()
 	}
 
@@ -138,23 +202,28 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	go result.startFileOpenProcessor()
 	go result.startUrlOpenProcessor()
 	go result.startSecondInstanceProcessor()
+	go result.startDownloadProcessor()
+	go result.startNetworkStatusProcessor()
 
 	return result
 }
 
 func (f *Frontend) startFileOpenProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "file open processor")
 	for filePath := range openFilepathBuffer {
 		f.ProcessOpenFileEvent(filePath)
 	}
 }
 
 func (f *Frontend) startUrlOpenProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "URL open processor")
 	for url := range openUrlBuffer {
 		f.ProcessOpenUrlEvent(url)
 	}
 }
 
 func (f *Frontend) startSecondInstanceProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "second instance processor")
 	for secondInstanceData := range secondInstanceBuffer {
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
@@ -164,12 +233,14 @@ func (f *Frontend) startSecondInstanceProcessor() {
 }
 
 func (f *Frontend) startMessageProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "message processor")
 	for message := range messageBuffer {
 		f.processMessage(message)
 	}
 }
 
 func (f *Frontend) startBindingsMessageProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "bindings message processor")
 	for msg := range bindingsMessageBuffer {
 		// Apple webkit doesn't provide origin of main frame. So we can't verify in case of iFrame that top level origin is allowed.
 		if !msg.isMainFrame {
@@ -194,12 +265,22 @@ func (f *Frontend) startBindingsMessageProcessor() {
 }
 
 func (f *Frontend) startRequestProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "request processor")
 	for request := range requestBuffer {
+		if f.serveCustomSchemeRequest(request) {
+			continue
+		}
+		if f.assets == nil {
+			// The assets are served by the dev server
+			assetserver.ServeWebViewRequestWithHandler(request, http.NotFoundHandler(), f.logger)
+			continue
+		}
 		f.assets.ServeWebViewRequest(request)
 	}
 }
 
 func (f *Frontend) startCallbackProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "callback processor")
 	for callback := range callbackBuffer {
 		err := f.handleCallback(callback)
 		if err != nil {
@@ -209,23 +290,29 @@ func (f *Frontend) startCallbackProcessor() {
 }
 
 func (f *Frontend) WindowReload() {
+	f.startupTimer.Reloading()
 	f.ExecJS("runtime.WindowReload();")
 }
 
 func (f *Frontend) WindowReloadApp() {
+	f.startupTimer.Reloading()
 	f.ExecJS(fmt.Sprintf("window.location.href = '%s';", f.startURL))
 }
 
 func (f *Frontend) WindowSetSystemDefaultTheme() {
+	f.mainWindow.SetAppearance(string(mac.DefaultAppearance))
 }
 
 func (f *Frontend) WindowSetLightTheme() {
+	f.mainWindow.SetAppearance(string(mac.NSAppearanceNameAqua))
 }
 
 func (f *Frontend) WindowSetDarkTheme() {
+	f.mainWindow.SetAppearance(string(mac.NSAppearanceNameDarkAqua))
 }
 
 func (f *Frontend) Run(ctx context.Context) error {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "startup")
 	f.ctx = ctx
 
 	if f.frontendOptions.SingleInstanceLock != nil {
@@ -242,11 +329,47 @@ func (f *Frontend) Run(ctx context.Context) error {
 		f.devtoolsEnabled = _devtoolsEnabled.(bool)
 	}
 
-	mainWindow := NewWindow(f.frontendOptions, f.debug, f.devtoolsEnabled)
+	mainWindow := NewWindow(f.frontendOptions, f.debug, f.devtoolsEnabled, customSchemeNames(f.customSchemes))
 	f.mainWindow = mainWindow
 	f.mainWindow.Center()
+	f.centerOnStartScreen()
+	f.restoreWindowState()
+
+	f.mainWindow.AddUserScript(selectionChangedJS, false)
+	f.mainWindow.AddUserScript(frontend.HighlightScript, false)
+
+	if f.frontendOptions.Mac != nil && len(f.frontendOptions.Mac.RequestRules) > 0 {
+		script, err := requestRulesScript(f.frontendOptions.Mac.RequestRules)
+		if err != nil {
+			return err
+		}
+		f.mainWindow.AddUserScript(script, true)
+	}
+
+	for _, userScript := range f.frontendOptions.UserScripts {
+		script, err := os.ReadFile(userScript.Path)
+		if err != nil {
+			return fmt.Errorf("unable to load user script: %w", err)
+		}
+		f.mainWindow.AddUserScript(string(script), userScript.InjectionTime == options.UserScriptAtDocumentStart)
+	}
+
+	if splash := f.frontendOptions.SplashScreen; splash != nil {
+		f.mainWindow.ShowSplashScreen(splash)
+		// Make sure a stuck frontend still ends up showing the main window
+		time.AfterFunc(splash.Timeout, f.hideSplashScreen)
+	}
+
+	C.StartNetworkMonitor()
+	if f.frontendOptions.Mac != nil && f.frontendOptions.Mac.ClipboardChangeEvents {
+		f.startClipboardWatcher()
+	}
+
+	f.startupWatchdog = frontend.StartStartupWatchdog(f, f.frontendOptions, f.logger, f.startURL.String())
+	f.startupTimer = frontend.NewStartupTimer(f.ctx, f.logger)
 
 	go func() {
+		defer frontend.ReportCrash(f.logger, f.frontendOptions, "OnStartup")
 		if f.frontendOptions.OnStartup != nil {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
@@ -255,6 +378,20 @@ func (f *Frontend) Run(ctx context.Context) error {
 	return nil
 }
 
+// centerOnStartScreen centers the window on options.App.StartScreen, if it exists
+func (f *Frontend) centerOnStartScreen() {
+	startScreen := f.frontendOptions.StartScreen
+	if startScreen == 0 {
+		return
+	}
+	screens, err := f.ScreenGetAll()
+	if err != nil || startScreen < 0 || startScreen > len(screens) {
+		f.logger.Warning("StartScreen %d doesn't exist, opening the window on the primary screen", startScreen)
+		return
+	}
+	f.mainWindow.CenterOnScreen(startScreen - 1)
+}
+
 func (f *Frontend) WindowCenter() {
 	f.mainWindow.Center()
 }
@@ -263,6 +400,14 @@ func (f *Frontend) WindowSetAlwaysOnTop(onTop bool) {
 	f.mainWindow.SetAlwaysOnTop(onTop)
 }
 
+func (f *Frontend) WindowSetMinimizable(minimizable bool) {
+	f.mainWindow.SetMinimizable(minimizable)
+}
+
+func (f *Frontend) WindowSetMaximizable(maximizable bool) {
+	f.mainWindow.SetMaximizable(maximizable)
+}
+
 func (f *Frontend) WindowSetPosition(x, y int) {
 	f.mainWindow.SetPosition(x, y)
 }
@@ -283,6 +428,26 @@ func (f *Frontend) WindowSetTitle(title string) {
 	f.mainWindow.SetTitle(title)
 }
 
+func (f *Frontend) WindowGetTitle() string {
+	return f.mainWindow.Title()
+}
+
+func (f *Frontend) WindowSetIcon(data []byte) {
+	if _, err := png.DecodeConfig(bytes.NewReader(data)); err != nil {
+		f.logger.Error("Unable to set the window icon, the image is not a valid PNG: %s", err)
+		return
+	}
+	f.mainWindow.SetIcon(data)
+}
+
+func (f *Frontend) WindowRequestUserAttention(critical bool) {
+	f.mainWindow.RequestUserAttention(critical)
+}
+
+func (f *Frontend) DockSetBadge(label string) {
+	f.mainWindow.DockSetBadge(label)
+}
+
 func (f *Frontend) WindowFullscreen() {
 	f.mainWindow.Fullscreen()
 }
@@ -311,6 +476,10 @@ func (f *Frontend) WindowMaximise() {
 	f.mainWindow.Maximise()
 }
 
+func (f *Frontend) WindowShowZoomMenu() {
+	f.mainWindow.ShowZoomMenu()
+}
+
 func (f *Frontend) WindowToggleMaximise() {
 	f.mainWindow.ToggleMaximise()
 }
@@ -342,10 +511,25 @@ func (f *Frontend) WindowSetBackgroundColour(col *options.RGBA) {
 	f.mainWindow.SetBackgroundColour(col.R, col.G, col.B, col.A)
 }
 
+func (f *Frontend) WebViewSetBackgroundColour(col *options.RGBA) {
+	if col == nil {
+		return
+	}
+	f.mainWindow.SetWebViewBackgroundColour(col.R, col.G, col.B, col.A)
+}
+
 func (f *Frontend) ScreenGetAll() ([]frontend.Screen, error) {
 	return GetAllScreens(f.mainWindow.context)
 }
 
+func (f *Frontend) WindowGetCurrentScreen() (*frontend.Screen, error) {
+	return GetCurrentScreen(f.mainWindow.context)
+}
+
+func (f *Frontend) TrimMemory() {
+	f.mainWindow.TrimMemory()
+}
+
 func (f *Frontend) WindowIsMaximised() bool {
 	return f.mainWindow.IsMaximised()
 }
@@ -363,41 +547,189 @@ func (f *Frontend) WindowIsFullscreen() bool {
 }
 
 func (f *Frontend) Quit() {
+	f.QuitWithCode(0)
+}
+
+func (f *Frontend) QuitWithCode(code int) {
 	if f.frontendOptions.OnBeforeClose != nil {
 		go func() {
 			if !f.frontendOptions.OnBeforeClose(f.ctx) {
-				f.mainWindow.Quit()
+				f.quit(code)
 			}
 		}()
 		return
 	}
+	f.quit(code)
+}
+
+func (f *Frontend) quit(code int) {
+	f.exitCode.Store(int32(code))
+	f.saveWindowState()
 	f.mainWindow.Quit()
 }
 
+func (f *Frontend) ExitCode() int {
+	return int(f.exitCode.Load())
+}
+
 func (f *Frontend) WindowPrint() {
 	f.mainWindow.Print()
 }
 
-type EventNotify struct {
-	Name string        `json:"name"`
-	Data []interface{} `json:"data"`
+func (f *Frontend) WindowOpenDevTools() {
+	if !f.devtoolsEnabled {
+		f.logger.Debug("Not opening the devtools, they are not enabled")
+		return
+	}
+	showInspector(f.mainWindow.context)
 }
 
-func (f *Frontend) Notify(name string, data ...interface{}) {
-	notification := EventNotify{
-		Name: name,
-		Data: data,
+func (f *Frontend) WindowCloseDevTools() {
+	if !f.devtoolsEnabled {
+		f.logger.Debug("Not closing the devtools, they are not enabled")
+		return
 	}
-	payload, err := json.Marshal(notification)
+	closeInspector(f.mainWindow.context)
+}
+
+func (f *Frontend) WindowSetCollectionBehavior(flags []string) error {
+	behaviour, err := collectionBehaviour(flags)
+	if err != nil {
+		return err
+	}
+	f.mainWindow.SetCollectionBehavior(behaviour)
+	return nil
+}
+
+func (f *Frontend) WindowSetSpellCheckEnabled(enabled bool) {
+	f.documentStateLock.Lock()
+	f.spellCheckEnabled = &enabled
+	f.documentStateLock.Unlock()
+
+	f.mainWindow.SetSpellCheckEnabled(enabled)
+	f.applySpellCheck()
+}
+
+func (f *Frontend) WindowSetSpellCheckLanguage(language string) {
+	f.mainWindow.SetSpellCheckLanguage(language)
+}
+
+func (f *Frontend) WindowSetEnabled(enabled bool) {
+	f.mainWindow.SetEnabled(enabled)
+}
+
+func (f *Frontend) WindowSetJavaScriptEnabled(enabled bool) {
+	f.mainWindow.SetJavaScriptEnabled(enabled)
+}
+
+func (f *Frontend) WindowSetShadow(enabled bool) {
+	f.mainWindow.SetShadow(enabled)
+}
+
+func (f *Frontend) WindowSetCornerRadius(radius float64) {
+	f.mainWindow.SetCornerRadius(radius)
+}
+
+func (f *Frontend) WindowBack() {
+	f.mainWindow.GoBack()
+}
+
+func (f *Frontend) WindowForward() {
+	f.mainWindow.GoForward()
+}
+
+func (f *Frontend) WindowCanGoBack() bool {
+	return f.mainWindow.CanGoBack()
+}
+
+func (f *Frontend) WindowCanGoForward() bool {
+	return f.mainWindow.CanGoForward()
+}
+
+func (f *Frontend) WindowStopLoading() {
+	f.mainWindow.StopLoading()
+}
+
+// NavigationEvent is emitted with the "wails:navigation:started", "wails:navigation:finished"
+// and "wails:navigation:failed" events
+type NavigationEvent struct {
+	URL   string `json:"url"`
+	Error string `json:"error,omitempty"`
+}
+
+// processNavigationMessage handles the "wails:navigation:<started|finished|failed>:<json>" messages
+// sent by the navigation delegate
+func (f *Frontend) processNavigationMessage(message string) {
+	kind, data, found := strings.Cut(strings.TrimPrefix(message, "wails:navigation:"), ":")
+	if !found {
+		return
+	}
+	var event NavigationEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		f.logger.Error("Invalid navigation message '%s': %s", message, err)
+		return
+	}
+	f.emit("wails:navigation:"+kind, event)
+}
+
+// NavigationHistory is emitted with the "wails:navigation:history" event when the webview
+// can go back or forward in its history
+type NavigationHistory struct {
+	CanGoBack    bool `json:"canGoBack"`
+	CanGoForward bool `json:"canGoForward"`
+}
+
+// applySpellCheck sets the spellcheck attribute on the document, which is inherited by all
+// editable elements that don't set it themselves
+func (f *Frontend) applySpellCheck() {
+	f.documentStateLock.Lock()
+	enabled := f.spellCheckEnabled
+	f.documentStateLock.Unlock()
+
+	if enabled == nil {
+		return
+	}
+	f.ExecJS(fmt.Sprintf("document.documentElement.spellcheck = %t;", *enabled))
+}
+
+func (f *Frontend) Notify(name string, data ...interface{}) {
+	js, err := frontend.EventNotifyJS(f.frontendOptions.EventEncoding, name, data)
 	if err != nil {
 		f.logger.Error(err.Error())
 		return
 	}
-	f.ExecJS(`window.wails.EventsNotify('` + template.JSEscapeString(string(payload)) + `');`)
+	f.ExecJS(js)
+}
+
+// NotifyThrottled notifies the frontend of the event at most once per minInterval for each event name.
+// The latest dropped event is sent when the interval ends
+func (f *Frontend) NotifyThrottled(name string, minInterval time.Duration, data ...interface{}) {
+	f.notifyThrottle.Notify(name, minInterval, data, f.Notify)
+}
+
+func (f *Frontend) hideSplashScreen() {
+	if f.frontendOptions.SplashScreen == nil {
+		return
+	}
+	f.hideSplashScreenOnce.Do(func() {
+		f.mainWindow.HideSplashScreen()
+		if !f.frontendOptions.StartHidden {
+			f.mainWindow.Show()
+		}
+	})
+}
+
+// emit sends an event to both the Go and the frontend listeners
+func (f *Frontend) emit(name string, data ...interface{}) {
+	if events, _ := f.ctx.Value("events").(frontend.Events); events != nil {
+		events.Emit(name, data...)
+	}
 }
 
 func (f *Frontend) processMessage(message string) {
 	if message == "DomReady" {
+		f.startupTimer.Ready(message)
+		f.hideSplashScreen()
 		if f.frontendOptions.OnDomReady != nil {
 			f.frontendOptions.OnDomReady(f.ctx)
 		}
@@ -405,6 +737,9 @@ func (f *Frontend) processMessage(message string) {
 	}
 
 	if message == "runtime:ready" {
+		f.startupWatchdog.Ready()
+		f.startupTimer.Ready(message)
+
 		cmd := fmt.Sprintf("window.wails.setCSSDragProperties('%s', '%s');", f.frontendOptions.CSSDragProperty, f.frontendOptions.CSSDragValue)
 		f.ExecJS(cmd)
 
@@ -412,6 +747,64 @@ func (f *Frontend) processMessage(message string) {
 			f.ExecJS("window.wails.flags.enableWailsDragAndDrop = true;")
 		}
 
+		f.applySpellCheck()
+		f.applyHighlight()
+		f.applyIdleMonitor()
+		if f.windowInactive {
+			f.ExecJS(frontend.WindowActiveJS(false))
+		}
+
+		return
+	}
+
+	if strings.HasPrefix(message, "wails:memorypressure:") {
+		if f.frontendOptions.Mac != nil && f.frontendOptions.Mac.TrimMemoryOnMemoryPressure {
+			f.TrimMemory()
+		}
+		f.emit("wails:system:memorypressure", strings.TrimPrefix(message, "wails:memorypressure:"))
+		return
+	}
+
+	if message == "wails:screen:changed" {
+		f.applySizeFractions()
+		f.emit("wails:screen:changed")
+		return
+	}
+
+	if message == "wails:window:active" || message == "wails:window:inactive" {
+		f.windowInactive = message == "wails:window:inactive"
+		f.ExecJS(frontend.WindowActiveJS(!f.windowInactive))
+		f.emit(message)
+		return
+	}
+
+	switch message {
+	case "wails:window:fullscreen", "wails:window:unfullscreen", "wails:window:minimise", "wails:window:restore":
+		f.emit(message)
+		return
+	}
+
+	if strings.HasPrefix(message, "wails:history:") {
+		history := strings.TrimPrefix(message, "wails:history:")
+		f.emit("wails:navigation:history", NavigationHistory{
+			CanGoBack:    strings.HasPrefix(history, "1"),
+			CanGoForward: strings.HasSuffix(history, "1"),
+		})
+		return
+	}
+
+	if strings.HasPrefix(message, "wails:navigation:") {
+		f.processNavigationMessage(message)
+		return
+	}
+
+	if message == frontend.IdleActivityMessage {
+		f.idleMonitor.Activity()
+		return
+	}
+
+	if strings.HasPrefix(message, frontend.SimulatedEventPrefix) {
+		f.processSimulatedEvent(message)
 		return
 	}
 
@@ -467,9 +860,28 @@ func (f *Frontend) Callback(message string) {
 }
 
 func (f *Frontend) ExecJS(js string) {
+	if f.execJSRecorder.Record(js) {
+		return
+	}
 	f.mainWindow.ExecJS(js)
 }
 
+// ExecJSRecordingStart records the JS sent to the webview, including events and callbacks. If passthrough is
+// false the JS isn't executed
+func (f *Frontend) ExecJSRecordingStart(passthrough bool) error {
+	return f.execJSRecorder.Start(passthrough)
+}
+
+// ExecJSRecordingDrain returns the recorded JS and clears the buffer
+func (f *Frontend) ExecJSRecordingDrain() []string {
+	return f.execJSRecorder.Drain()
+}
+
+// ExecJSRecordingStop stops recording and returns the JS which hasn't been drained
+func (f *Frontend) ExecJSRecordingStop() []string {
+	return f.execJSRecorder.Stop()
+}
+
 //func (f *Frontend) processSystemEvent(message string) {
 //	sl := strings.Split(message, ":")
 //	if len(sl) != 2 {
@@ -0,0 +1,38 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// HighlightElement draws an overlay around the first element matching the selector until
+// ClearHighlight is called. The highlight is restored when the page reloads
+func (f *Frontend) HighlightElement(selector string) {
+	f.documentStateLock.Lock()
+	f.highlightSelector = selector
+	f.documentStateLock.Unlock()
+
+	f.ExecJS(frontend.HighlightElementJS(selector))
+}
+
+// ClearHighlight removes the current highlight
+func (f *Frontend) ClearHighlight() {
+	f.documentStateLock.Lock()
+	f.highlightSelector = ""
+	f.documentStateLock.Unlock()
+
+	f.ExecJS(frontend.ClearHighlightJS)
+}
+
+func (f *Frontend) applyHighlight() {
+	f.documentStateLock.Lock()
+	selector := f.highlightSelector
+	f.documentStateLock.Unlock()
+
+	if selector == "" {
+		return
+	}
+	f.ExecJS(frontend.HighlightElementJS(selector))
+}
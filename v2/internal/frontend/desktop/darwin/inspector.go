@@ -8,3 +8,6 @@ import (
 
 func showInspector(_ unsafe.Pointer) {
 }
+
+func closeInspector(_ unsafe.Pointer) {
+}
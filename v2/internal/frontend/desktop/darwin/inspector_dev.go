@@ -16,6 +16,7 @@ extern void processMessage(const char *message);
 @interface _WKInspector : NSObject
 - (void)show;
 - (void)detach;
+- (void)close;
 @end
 
 @interface WKWebView ()
@@ -51,6 +52,22 @@ void showInspector(void *inctx) {
 #endif
 }
 
+void closeInspector(void *inctx) {
+#if MAC_OS_X_VERSION_MAX_ALLOWED >= 120000
+    ON_MAIN_THREAD(
+		if (@available(macOS 12.0, *)) {
+			WailsContext *ctx = (__bridge WailsContext*) inctx;
+
+			@try {
+				[ctx.webview._inspector close];
+			} @catch (NSException *exception) {
+				NSLog(@"Closing the inspector failed: %@", exception.reason);
+			}
+		}
+    );
+#endif
+}
+
 void setupF12hotkey() {
 	[NSEvent addLocalMonitorForEventsMatchingMask:NSEventMaskKeyDown handler:^NSEvent * _Nullable(NSEvent * _Nonnull event) {
 		if (event.keyCode == 111 &&
@@ -76,3 +93,7 @@ func init() {
 func showInspector(context unsafe.Pointer) {
 	C.showInspector(context)
 }
+
+func closeInspector(context unsafe.Pointer) {
+	C.closeInspector(context)
+}
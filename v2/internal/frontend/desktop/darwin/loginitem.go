@@ -0,0 +1,91 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework ServiceManagement
+#import <Foundation/Foundation.h>
+#import <ServiceManagement/ServiceManagement.h>
+
+#include <stdlib.h>
+#include <string.h>
+
+// Returns 1 if the app is launched at login, 0 if not and -1 if it's not supported
+int GetLaunchAtLogin() {
+	if (@available(macOS 13.0, *)) {
+		return [[SMAppService mainAppService] status] == SMAppServiceStatusEnabled;
+	}
+	return -1;
+}
+
+// Returns NULL on success, otherwise the error which must be freed
+char* SetLaunchAtLogin(int enabled) {
+	if (@available(macOS 13.0, *)) {
+		SMAppService *service = [SMAppService mainAppService];
+		if (!enabled && [service status] == SMAppServiceStatusNotRegistered) {
+			return NULL;
+		}
+		NSError *error = nil;
+		BOOL success = enabled ? [service registerAndReturnError:&error] : [service unregisterAndReturnError:&error];
+		if (!success) {
+			return strdup([[error localizedDescription] UTF8String]);
+		}
+		return NULL;
+	}
+	return strdup("launch at login requires macOS 13 or later");
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// SetLaunchAtLogin registers or unregisters the app as a login item. The user might have to approve it in the
+// System Settings, until then GetLaunchAtLogin returns false
+func (f *Frontend) SetLaunchAtLogin(enabled bool) error {
+	if enabled {
+		f.checkLoginItemLocation()
+	}
+
+	if message := C.SetLaunchAtLogin(bool2Cint(enabled)); message != nil {
+		defer C.free(unsafe.Pointer(message))
+		return fmt.Errorf("unable to set launch at login: %s", C.GoString(message))
+	}
+	return nil
+}
+
+// GetLaunchAtLogin returns true if the app is launched when the user logs in
+func (f *Frontend) GetLaunchAtLogin() (bool, error) {
+	switch C.GetLaunchAtLogin() {
+	case -1:
+		return false, errors.New("launch at login requires macOS 13 or later")
+	case 1:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// checkLoginItemLocation warns if the app isn't installed in an Applications folder, login items of apps elsewhere,
+// EG: in the build directory or a mounted disk image, are unreliable
+func (f *Frontend) checkLoginItemLocation() {
+	executable, err := os.Executable()
+	if err != nil {
+		return
+	}
+	home, _ := os.UserHomeDir()
+	for _, dir := range []string{"/Applications", filepath.Join(home, "Applications")} {
+		if strings.HasPrefix(executable, dir+"/") {
+			return
+		}
+	}
+	f.logger.Warning("The app is not installed in /Applications, launching it at login might not work: %s", executable)
+}
@@ -0,0 +1,53 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// Obj-C runs the function registered for the callback ID on the main queue
+var (
+	mainThreadCallbacks     = make(map[int]func())
+	mainThreadCallbacksLock sync.Mutex
+	mainThreadCallbackID    int
+)
+
+func (f *Frontend) RunOnMainThread(fn func()) {
+	frontend.RunOnMainThread(dispatchOnMainThread, fn)
+}
+
+// dispatchOnMainThread runs fn on the main queue, or straight away if it is called on the main thread
+func dispatchOnMainThread(fn func()) {
+	mainThreadCallbacksLock.Lock()
+	mainThreadCallbackID++
+	callbackID := mainThreadCallbackID
+	mainThreadCallbacks[callbackID] = fn
+	mainThreadCallbacksLock.Unlock()
+
+	C.RunOnMainThread(C.int(callbackID))
+}
+
+//export processMainThreadCallback
+func processMainThreadCallback(callbackID C.int) {
+	mainThreadCallbacksLock.Lock()
+	fn, ok := mainThreadCallbacks[int(callbackID)]
+	delete(mainThreadCallbacks, int(callbackID))
+	mainThreadCallbacksLock.Unlock()
+	if ok {
+		fn()
+	}
+}
@@ -21,6 +21,15 @@ void processMessageDialogResponse(int);
 void processOpenFileDialogResponse(const char*);
 void processSaveFileDialogResponse(const char*);
 void processCallback(int);
+void processContentRulesResponse(const char*);
+void processExecJSResult(int, const char*, const char*);
+void processMainThreadCallback(int);
+void processSessionState(void*, int, const char*);
+void processRestoreSessionStateResponse(const char*);
+void processDownloadRequest(int, const char*, const char*);
+void processDownloadProgress(int, long long, long long);
+void processDownloadFinished(int, const char*);
+void processNetworkStatus(bool, bool, bool);
 
 #ifdef __cplusplus
 }
@@ -0,0 +1,77 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Network
+#import <Foundation/Foundation.h>
+#import "WailsNetwork.h"
+
+#include <stdbool.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// Obj-C sends the network status to this channel when it's known and whenever it changes
+var networkStatusBuffer = make(chan frontend.NetworkStatus, 10)
+
+var (
+	networkStatusLock  sync.Mutex
+	networkStatus      *frontend.NetworkStatus
+	networkStatusKnown = make(chan struct{})
+)
+
+//export processNetworkStatus
+func processNetworkStatus(online C.bool, expensive C.bool, constrained C.bool) {
+	networkStatusBuffer <- frontend.NetworkStatus{
+		Online:      bool(online),
+		Expensive:   bool(expensive),
+		Constrained: bool(constrained),
+	}
+}
+
+// startNetworkStatusProcessor emits "wails:network:online" and "wails:network:offline" when the connectivity changes,
+// with the NetworkStatus as data
+func (f *Frontend) startNetworkStatusProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "network status processor")
+	for status := range networkStatusBuffer {
+		networkStatusLock.Lock()
+		previous := networkStatus
+		networkStatus = &status
+		if previous == nil {
+			close(networkStatusKnown)
+		}
+		networkStatusLock.Unlock()
+
+		if previous == nil || previous.Online == status.Online {
+			continue
+		}
+		if status.Online {
+			f.emit("wails:network:online", status)
+		} else {
+			f.emit("wails:network:offline", status)
+		}
+	}
+}
+
+// GetNetworkStatus returns the current network connectivity
+func (f *Frontend) GetNetworkStatus() (frontend.NetworkStatus, error) {
+	select {
+	case <-networkStatusKnown:
+	case <-time.After(time.Second):
+		return frontend.NetworkStatus{}, errors.New("the network status is not known yet")
+	}
+
+	networkStatusLock.Lock()
+	defer networkStatusLock.Unlock()
+	return *networkStatus, nil
+}
@@ -0,0 +1,45 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// RelaunchApp starts a new instance of the application with the given args and quits this one. Nothing happens if
+// OnBeforeClose prevents quitting
+func (f *Frontend) RelaunchApp(args []string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("unable to relaunch the application: %w", err)
+	}
+
+	if f.frontendOptions.OnBeforeClose != nil && f.frontendOptions.OnBeforeClose(f.ctx) {
+		return nil
+	}
+
+	// The new instance must not find the lock of this one, otherwise it passes its args to this instance and exits
+	lock := f.frontendOptions.SingleInstanceLock
+	if lock != nil && f.singleInstanceLockFile != nil {
+		f.singleInstanceLockFile.Close()
+		f.singleInstanceLockFile = nil
+	}
+
+	cmd := exec.Command(executable, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		if lock != nil {
+			f.singleInstanceLockFile, _ = createLockFile(lockFilePath(lock.UniqueId))
+		}
+		return fmt.Errorf("unable to relaunch the application: %w", err)
+	}
+	// Don't wait for the new instance, it outlives this one
+	_ = cmd.Process.Release()
+
+	f.quit(0)
+	return nil
+}
@@ -0,0 +1,80 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/pkg/options/mac"
+)
+
+// requestRulesJS patches fetch and XMLHttpRequest so that requests matching one of the rules
+// get the rule's headers set and optionally their host rewritten.
+const requestRulesJS = `(function(rules) {
+    function resolve(url) {
+        return new URL(url, window.location.href);
+    }
+    function match(url) {
+        var href = resolve(url).href;
+        for (var i = 0; i < rules.length; i++) {
+            if (href.indexOf(rules[i].urlPrefix) === 0) {
+                return rules[i];
+            }
+        }
+        return null;
+    }
+    function rewrite(url, rule) {
+        if (!rule.rewriteHost) {
+            return url;
+        }
+        var u = resolve(url);
+        u.host = rule.rewriteHost;
+        return u.href;
+    }
+
+    var originalFetch = window.fetch;
+    window.fetch = function(input, init) {
+        var request = new Request(input, init);
+        var rule = match(request.url);
+        if (rule === null) {
+            return originalFetch.call(this, input, init);
+        }
+        if (rule.rewriteHost) {
+            request = new Request(rewrite(request.url, rule), request);
+        }
+        for (var name in rule.headers || {}) {
+            request.headers.set(name, rule.headers[name]);
+        }
+        return originalFetch.call(this, request);
+    };
+
+    var originalOpen = XMLHttpRequest.prototype.open;
+    XMLHttpRequest.prototype.open = function(method, url) {
+        var args = Array.prototype.slice.call(arguments);
+        this.__wailsRequestRule = match(String(url));
+        if (this.__wailsRequestRule !== null) {
+            args[1] = rewrite(String(url), this.__wailsRequestRule);
+        }
+        return originalOpen.apply(this, args);
+    };
+    var originalSend = XMLHttpRequest.prototype.send;
+    XMLHttpRequest.prototype.send = function() {
+        var rule = this.__wailsRequestRule;
+        if (rule) {
+            for (var name in rule.headers || {}) {
+                this.setRequestHeader(name, rule.headers[name]);
+            }
+        }
+        return originalSend.apply(this, arguments);
+    };
+})(%s);`
+
+func requestRulesScript(rules []mac.RequestRule) (string, error) {
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal request rules: %w", err)
+	}
+	return fmt.Sprintf(requestRulesJS, data), nil
+}
@@ -20,6 +20,7 @@ typedef struct Screen {
 	int width;
 	int pHeight;
 	int pWidth;
+	double scaleFactor;
 } Screen;
 
 
@@ -50,6 +51,7 @@ Screen GetNthScreen(int nth, void *inctx){
 	returnScreen.isPrimary = nth==0;
 	returnScreen.height = (int) nthScreen.frame.size.height;
 	returnScreen.width =  (int) nthScreen.frame.size.width;
+	returnScreen.scaleFactor = nthScreen.backingScaleFactor;
 
 	returnScreen.pWidth = 0;
 	returnScreen.pHeight = 0;
@@ -80,10 +82,28 @@ Screen GetNthScreen(int nth, void *inctx){
 	return returnScreen;
 }
 
+// GetCurrentScreenIndex returns the index of the screen which contains the center of the window
+int GetCurrentScreenIndex(void *inctx){
+	WailsContext *ctx = (__bridge WailsContext*) inctx;
+	NSArray<NSScreen *> *screens = [NSScreen screens];
+	NSRect frame = [ctx.mainWindow frame];
+	NSPoint center = NSMakePoint(NSMidX(frame), NSMidY(frame));
+	for (int i = 0; i < screens.count; i++) {
+		if (NSPointInRect(center, [screens objectAtIndex:i].frame)) {
+			return i;
+		}
+	}
+
+	// The center is off screen, use the screen with the largest part of the window
+	NSUInteger index = [screens indexOfObject:[ctx getCurrentScreen]];
+	return index == NSNotFound ? 0 : (int)index;
+}
+
 */
 import "C"
 
 import (
+	"errors"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
@@ -96,23 +116,36 @@ func GetAllScreens(wailsContext unsafe.Pointer) ([]frontend.Screen, error) {
 	for screeNum := 0; screeNum < numScreens; screeNum++ {
 		screenNumC := C.int(screeNum)
 		cScreen := C.GetNthScreen(screenNumC, wailsContext)
-
-		screen := frontend.Screen{
-			Height:    int(cScreen.height),
-			Width:     int(cScreen.width),
-			IsCurrent: cScreen.isCurrent == C.int(1),
-			IsPrimary: cScreen.isPrimary == C.int(1),
-
-			Size: frontend.ScreenSize{
-				Height: int(cScreen.height),
-				Width:  int(cScreen.width),
-			},
-			PhysicalSize: frontend.ScreenSize{
-				Height: int(cScreen.pHeight),
-				Width:  int(cScreen.pWidth),
-			},
-		}
-		screens = append(screens, screen)
+		screens = append(screens, screenFromC(cScreen))
 	}
 	return screens, err
 }
+
+// GetCurrentScreen returns the screen which contains the center of the window
+func GetCurrentScreen(wailsContext unsafe.Pointer) (*frontend.Screen, error) {
+	if int(C.GetNumScreens()) == 0 {
+		return nil, errors.New("no screens found")
+	}
+	screen := screenFromC(C.GetNthScreen(C.GetCurrentScreenIndex(wailsContext), wailsContext))
+	screen.IsCurrent = true
+	return &screen, nil
+}
+
+func screenFromC(cScreen C.Screen) frontend.Screen {
+	return frontend.Screen{
+		Height:    int(cScreen.height),
+		Width:     int(cScreen.width),
+		IsCurrent: cScreen.isCurrent == C.int(1),
+		IsPrimary: cScreen.isPrimary == C.int(1),
+
+		Size: frontend.ScreenSize{
+			Height: int(cScreen.height),
+			Width:  int(cScreen.width),
+		},
+		PhysicalSize: frontend.ScreenSize{
+			Height: int(cScreen.pHeight),
+			Width:  int(cScreen.pWidth),
+		},
+		ScaleFactor: float64(cScreen.scaleFactor),
+	}
+}
@@ -0,0 +1,36 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// selectionChangedJS emits the "wails:selection:changed" event with the selected text once
+// the selection has settled
+const selectionChangedJS = `(function() {
+    var timeout;
+    document.addEventListener("selectionchange", function() {
+        clearTimeout(timeout);
+        timeout = setTimeout(function() {
+            if (window.runtime && window.runtime.EventsEmit) {
+                window.runtime.EventsEmit("wails:selection:changed", window.getSelection().toString());
+            }
+        }, 200);
+    });
+})();`
+
+// GetSelectedText returns the text currently selected in the webview
+func (f *Frontend) GetSelectedText() (string, error) {
+	result, err := f.execJSWithResult("window.getSelection().toString()")
+	if err != nil {
+		return "", fmt.Errorf("unable to get selected text: %w", err)
+	}
+	var text string
+	if err := json.Unmarshal([]byte(result), &text); err != nil {
+		return "", fmt.Errorf("unable to get selected text: %w", err)
+	}
+	return text, nil
+}
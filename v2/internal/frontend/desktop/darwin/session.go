@@ -0,0 +1,85 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation -framework Cocoa -framework WebKit
+#import <Foundation/Foundation.h>
+#import "Application.h"
+
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+type sessionStateResult struct {
+	state []byte
+	err   error
+}
+
+// Obj-C sends the results of getting and restoring the session state to these channels
+var (
+	sessionStateResponse        = make(chan sessionStateResult)
+	restoreSessionStateResponse = make(chan string)
+	sessionStateLock            sync.Mutex
+)
+
+var errSessionStateUnsupported = errors.New("session state requires macOS 12 or later")
+
+// GetSessionState returns the opaque interaction state of the webview, including the navigation
+// history, scroll positions and form data. It can be restored using RestoreSessionState
+func (f *Frontend) GetSessionState() ([]byte, error) {
+	if !bool(C.SupportsSessionState()) {
+		return nil, errSessionStateUnsupported
+	}
+
+	sessionStateLock.Lock()
+	defer sessionStateLock.Unlock()
+
+	C.GetSessionState(f.mainWindow.context)
+	result := <-sessionStateResponse
+	return result.state, result.err
+}
+
+// RestoreSessionState restores a state returned by GetSessionState
+func (f *Frontend) RestoreSessionState(state []byte) error {
+	if !bool(C.SupportsSessionState()) {
+		return errSessionStateUnsupported
+	}
+	if len(state) == 0 {
+		return errors.New("session state is empty")
+	}
+
+	sessionStateLock.Lock()
+	defer sessionStateLock.Unlock()
+
+	_state := C.CBytes(state)
+	C.RestoreSessionState(f.mainWindow.context, _state, C.int(len(state)))
+	C.free(_state)
+
+	if errMessage := <-restoreSessionStateResponse; errMessage != "" {
+		return errors.New("unable to restore session state: " + errMessage)
+	}
+	return nil
+}
+
+//export processSessionState
+func processSessionState(data unsafe.Pointer, length C.int, cerror *C.char) {
+	if errMessage := C.GoString(cerror); errMessage != "" {
+		sessionStateResponse <- sessionStateResult{err: errors.New("unable to get session state: " + errMessage)}
+		return
+	}
+	sessionStateResponse <- sessionStateResult{state: C.GoBytes(data, length)}
+}
+
+//export processRestoreSessionStateResponse
+func processRestoreSessionStateResponse(cerror *C.char) {
+	restoreSessionStateResponse <- C.GoString(cerror)
+}
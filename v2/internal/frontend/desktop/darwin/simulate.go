@@ -0,0 +1,38 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// processSimulatedEvent feeds a synthetic OS event from the frontend into the same buffers
+// the native callbacks use, so the app's handlers can be tested end-to-end
+func (f *Frontend) processSimulatedEvent(message string) {
+	if !f.debug {
+		f.logger.Error("Simulated events are only available in debug builds")
+		return
+	}
+
+	event, err := frontend.ParseSimulatedEvent(message)
+	if err != nil {
+		f.logger.Error(err.Error())
+		return
+	}
+
+	switch event.Type {
+	case frontend.SimulatedFileOpen:
+		openFilepathBuffer <- event.FilePath
+	case frontend.SimulatedURLOpen:
+		openUrlBuffer <- event.URL
+	case frontend.SimulatedSecondInstance:
+		secondInstanceBuffer <- event.SecondInstanceData()
+	case frontend.SimulatedThemeChange:
+		if err := frontend.SimulateThemeChange(f, event.Theme); err != nil {
+			f.logger.Error(err.Error())
+		}
+	default:
+		f.logger.Error("Unknown simulated event type '%s'", event.Type)
+	}
+}
@@ -23,9 +23,7 @@ import (
 )
 
 func SetupSingleInstance(uniqueID string) *os.File {
-	lockFilePath := getTempDir()
-	lockFileName := uniqueID + ".lock"
-	file, err := createLockFile(lockFilePath + "/" + lockFileName)
+	file, err := createLockFile(lockFilePath(uniqueID))
 	// if lockFile exist – send notification to second instance
 	if err != nil {
 		c := NewCalloc()
@@ -62,6 +60,10 @@ func HandleSecondInstanceData(secondInstanceMessage *C.char) {
 	}
 }
 
+func lockFilePath(uniqueID string) string {
+	return getTempDir() + "/" + uniqueID + ".lock"
+}
+
 // createLockFile tries to create a file with given name and acquire an
 // exclusive lock on it. If the file already exists AND is still locked, it will
 // fail.
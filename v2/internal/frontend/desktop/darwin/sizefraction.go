@@ -0,0 +1,68 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// sizeFraction is a window size relative to the size of the current screen
+type sizeFraction struct {
+	width  float64
+	height float64
+}
+
+// WindowSetMinSizeFraction sets the minimum size of the window to the given fractions of the current screen.
+// It is updated when the window moves to another screen or the screen resolution changes. 0 removes it
+func (f *Frontend) WindowSetMinSizeFraction(widthFraction float64, heightFraction float64) {
+	f.sizeFractionLock.Lock()
+	f.minSizeFraction = sizeFraction{width: widthFraction, height: heightFraction}
+	f.sizeFractionLock.Unlock()
+
+	if widthFraction == 0 && heightFraction == 0 {
+		f.WindowSetMinSize(0, 0)
+	}
+	f.applySizeFractions()
+}
+
+// WindowSetMaxSizeFraction sets the maximum size of the window to the given fractions of the current screen.
+// It is updated when the window moves to another screen or the screen resolution changes. 0 removes it
+func (f *Frontend) WindowSetMaxSizeFraction(widthFraction float64, heightFraction float64) {
+	f.sizeFractionLock.Lock()
+	f.maxSizeFraction = sizeFraction{width: widthFraction, height: heightFraction}
+	f.sizeFractionLock.Unlock()
+
+	if widthFraction == 0 && heightFraction == 0 {
+		f.WindowSetMaxSize(0, 0)
+	}
+	f.applySizeFractions()
+}
+
+// applySizeFractions computes the size constraints of the window for the current screen
+func (f *Frontend) applySizeFractions() {
+	f.sizeFractionLock.Lock()
+	minSize, maxSize := f.minSizeFraction, f.maxSizeFraction
+	f.sizeFractionLock.Unlock()
+
+	if minSize == (sizeFraction{}) && maxSize == (sizeFraction{}) {
+		return
+	}
+
+	screens, err := f.ScreenGetAll()
+	if err != nil {
+		f.logger.Error("Unable to get the screens: %s", err)
+		return
+	}
+
+	if minSize != (sizeFraction{}) {
+		if width, height, ok := frontend.ScreenFractionSize(screens, minSize.width, minSize.height); ok {
+			f.WindowSetMinSize(width, height)
+		}
+	}
+	if maxSize != (sizeFraction{}) {
+		if width, height, ok := frontend.ScreenFractionSize(screens, maxSize.width, maxSize.height); ok {
+			f.WindowSetMaxSize(width, height)
+		}
+	}
+}
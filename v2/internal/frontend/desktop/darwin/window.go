@@ -48,7 +48,7 @@ func bool2CboolPtr(value bool) *C.bool {
 	return &v
 }
 
-func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window {
+func NewWindow(frontendOptions *options.App, debug bool, devtools bool, customSchemes string) *Window {
 	c := NewCalloc()
 	defer c.Free()
 
@@ -57,7 +57,8 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 	fullscreen := bool2Cint(frontendOptions.Fullscreen)
 	alwaysOnTop := bool2Cint(frontendOptions.AlwaysOnTop)
 	hideWindowOnClose := bool2Cint(frontendOptions.HideWindowOnClose)
-	startsHidden := bool2Cint(frontendOptions.StartHidden)
+	// The main window is shown once the splash screen has been dismissed
+	startsHidden := bool2Cint(frontendOptions.StartHidden || frontendOptions.SplashScreen != nil)
 	devtoolsEnabled := bool2Cint(devtools)
 	defaultContextMenuEnabled := bool2Cint(debug || frontendOptions.EnableDefaultContextMenu)
 	singleInstanceEnabled := bool2Cint(frontendOptions.SingleInstanceLock != nil)
@@ -82,6 +83,7 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 		singleInstanceUniqueIdStr = frontendOptions.SingleInstanceLock.UniqueId
 	}
 	singleInstanceUniqueId := c.String(singleInstanceUniqueIdStr)
+	customSchemesStr := c.String(customSchemes)
 
 	enableFraudulentWebsiteWarnings := C.bool(frontendOptions.EnableFraudulentWebsiteDetection)
 
@@ -125,7 +127,7 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 		hideTitleBar, titlebarAppearsTransparent, hideTitle, useToolbar, hideToolbarSeparator, webviewIsTransparent,
 		alwaysOnTop, hideWindowOnClose, appearance, windowIsTranslucent, contentProtection, devtoolsEnabled, defaultContextMenuEnabled,
 		windowStartState, startsHidden, minWidth, minHeight, maxWidth, maxHeight, enableFraudulentWebsiteWarnings,
-		preferences, singleInstanceEnabled, singleInstanceUniqueId, enableDragAndDrop, disableWebViewDragAndDrop,
+		preferences, singleInstanceEnabled, singleInstanceUniqueId, enableDragAndDrop, disableWebViewDragAndDrop, customSchemesStr,
 	)
 
 	// Create menu
@@ -163,6 +165,27 @@ func (w *Window) Center() {
 	C.Center(w.context)
 }
 
+// CenterOnScreen centers the window on the screen with the index in the order of GetAllScreens
+func (w *Window) CenterOnScreen(index int) {
+	C.CenterOnScreen(w.context, C.int(index))
+}
+
+func (w *Window) ShowSplashScreen(splash *options.SplashScreen) {
+	var image unsafe.Pointer
+	var length C.int
+	if len(splash.Image) > 0 {
+		image = unsafe.Pointer(&splash.Image[0])
+		length = C.int(len(splash.Image))
+	}
+	html := C.CString(splash.HTML)
+	C.ShowSplashScreen(w.context, image, length, html, C.int(splash.Width), C.int(splash.Height))
+	C.free(unsafe.Pointer(html))
+}
+
+func (w *Window) HideSplashScreen() {
+	C.HideSplashScreen(w.context)
+}
+
 func (w *Window) Run(url string) {
 	_url := C.CString(url)
 	C.Run(w.context, _url)
@@ -177,6 +200,70 @@ func (w *Window) SetBackgroundColour(r uint8, g uint8, b uint8, a uint8) {
 	C.SetBackgroundColour(w.context, C.int(r), C.int(g), C.int(b), C.int(a))
 }
 
+func (w *Window) SetWebViewBackgroundColour(r uint8, g uint8, b uint8, a uint8) {
+	C.SetWebViewBackgroundColour(w.context, C.int(r), C.int(g), C.int(b), C.int(a))
+}
+
+func (w *Window) AddUserScript(js string, atDocumentStart bool) {
+	_js := C.CString(js)
+	C.AddUserScript(w.context, _js, bool2Cint(atDocumentStart))
+	C.free(unsafe.Pointer(_js))
+}
+
+func (w *Window) SetCollectionBehavior(behaviour uint) {
+	C.SetCollectionBehavior(w.context, C.ulong(behaviour))
+}
+
+func (w *Window) SetSpellCheckEnabled(enabled bool) {
+	C.SetSpellCheckEnabled(w.context, bool2Cint(enabled))
+}
+
+func (w *Window) SetEnabled(enabled bool) {
+	C.SetEnabled(w.context, bool2Cint(enabled))
+}
+
+func (w *Window) SetJavaScriptEnabled(enabled bool) {
+	C.SetJavaScriptEnabled(w.context, bool2Cint(enabled))
+}
+
+func (w *Window) SetShadow(enabled bool) {
+	C.SetShadow(w.context, bool2Cint(enabled))
+}
+
+func (w *Window) SetCornerRadius(radius float64) {
+	C.SetCornerRadius(w.context, C.double(radius))
+}
+
+func (w *Window) GoBack() {
+	C.GoBack(w.context)
+}
+
+func (w *Window) GoForward() {
+	C.GoForward(w.context)
+}
+
+func (w *Window) CanGoBack() bool {
+	return bool(C.CanGoBack(w.context))
+}
+
+func (w *Window) CanGoForward() bool {
+	return bool(C.CanGoForward(w.context))
+}
+
+func (w *Window) StopLoading() {
+	C.StopLoading(w.context)
+}
+
+func (w *Window) SetSpellCheckLanguage(language string) {
+	_language := C.CString(language)
+	C.SetSpellCheckLanguage(w.context, _language)
+	C.free(unsafe.Pointer(_language))
+}
+
+func (w *Window) TrimMemory() {
+	C.TrimMemory(w.context)
+}
+
 func (w *Window) ExecJS(js string) {
 	_js := C.CString(js)
 	C.ExecJS(w.context, _js)
@@ -195,16 +282,50 @@ func (w *Window) SetAlwaysOnTop(onTop bool) {
 	C.SetAlwaysOnTop(w.context, bool2Cint(onTop))
 }
 
+func (w *Window) SetMinimizable(minimizable bool) {
+	C.SetMinimizable(w.context, bool2Cint(minimizable))
+}
+
+func (w *Window) SetMaximizable(maximizable bool) {
+	C.SetMaximizable(w.context, bool2Cint(maximizable))
+}
+
+func (w *Window) SetAppearance(appearance string) {
+	_appearance := C.CString(appearance)
+	C.SetAppearance(w.context, _appearance)
+	C.free(unsafe.Pointer(_appearance))
+}
+
 func (w *Window) SetTitle(title string) {
 	t := C.CString(title)
 	C.SetTitle(w.context, t)
 	C.free(unsafe.Pointer(t))
 }
 
+func (w *Window) DockSetBadge(label string) {
+	l := C.CString(label)
+	C.DockSetBadge(w.context, l)
+	C.free(unsafe.Pointer(l))
+}
+
+func (w *Window) RequestUserAttention(critical bool) {
+	C.RequestUserAttention(w.context, bool2Cint(critical))
+}
+
+func (w *Window) SetIcon(data []byte) {
+	icon := C.CBytes(data)
+	C.SetIcon(w.context, icon, C.int(len(data)))
+	C.free(icon)
+}
+
 func (w *Window) Maximise() {
 	C.Maximise(w.context)
 }
 
+func (w *Window) ShowZoomMenu() {
+	C.ShowZoomMenu(w.context)
+}
+
 func (w *Window) ToggleMaximise() {
 	C.ToggleMaximise(w.context)
 }
@@ -288,6 +409,18 @@ func (w *Window) GetPosition() (int, int) {
 	return parseIntDuo(temp)
 }
 
+// NormalGeometry returns the position and size of the window when it's neither maximised nor fullscreen
+func (w *Window) NormalGeometry() (x int, y int, width int, height int) {
+	geometry := strings.Split(C.GoString(C.GetNormalGeometry(w.context)), ",")
+	x, y = parseIntDuo(geometry[0] + "," + geometry[1])
+	width, height = parseIntDuo(geometry[2] + "," + geometry[3])
+	return x, y, width, height
+}
+
+func (w *Window) Title() string {
+	return C.GoString(C.GetTitle(w.context))
+}
+
 func (w *Window) Size() (int, int) {
 	var _result *C.char = C.GetSize(w.context)
 	temp := C.GoString(_result)
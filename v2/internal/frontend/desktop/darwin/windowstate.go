@@ -0,0 +1,64 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"errors"
+	"os"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// restoreWindowState applies the window state saved at options.App.WindowStatePath. The geometry is restored
+// before maximising the window, so un-maximising the window returns to it
+func (f *Frontend) restoreWindowState() {
+	path := f.frontendOptions.WindowStatePath
+	if path == "" {
+		return
+	}
+
+	state, err := frontend.LoadWindowState(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			f.logger.Error("Unable to load the window state: %s", err)
+		}
+		return
+	}
+
+	if state.Width > 0 && state.Height > 0 {
+		f.mainWindow.SetSize(state.Width, state.Height)
+		f.mainWindow.SetPosition(state.X, state.Y)
+	}
+
+	switch state.State {
+	case frontend.WindowStateMaximised:
+		f.mainWindow.Maximise()
+	case frontend.WindowStateFullscreen:
+		f.mainWindow.Fullscreen()
+	}
+}
+
+// saveWindowState saves the window state to options.App.WindowStatePath. A minimised window is saved as normal
+func (f *Frontend) saveWindowState() {
+	path := f.frontendOptions.WindowStatePath
+	if path == "" {
+		return
+	}
+
+	state := &frontend.SavedWindowState{State: frontend.WindowGetState(f)}
+	if state.State == frontend.WindowStateMinimised {
+		state.State = frontend.WindowStateNormal
+	}
+	state.X, state.Y, state.Width, state.Height = f.mainWindow.NormalGeometry()
+	if state.Width == 0 || state.Height == 0 {
+		// The geometry before maximising is unknown, keep the previously saved one
+		if previous, err := frontend.LoadWindowState(path); err == nil {
+			state.X, state.Y, state.Width, state.Height = previous.X, previous.Y, previous.Width, previous.Height
+		}
+	}
+
+	if err := state.Save(path); err != nil {
+		f.logger.Error("Unable to save the window state: %s", err)
+	}
+}
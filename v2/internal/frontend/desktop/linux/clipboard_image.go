@@ -0,0 +1,150 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// clipboardImageTool reads and writes clipboard images with an external utility, as
+// wl-clipboard on Wayland and xclip on X11
+type clipboardImageTool struct {
+	name        string
+	executables []string
+	// listTypes lists the MIME types on the clipboard, one per line
+	listTypes func() *exec.Cmd
+	// read writes the clipboard content of the type to stdout
+	read func(mime string) *exec.Cmd
+	// write reads the clipboard content of the type from stdin
+	write func(mime string) *exec.Cmd
+}
+
+var (
+	wlClipboard = clipboardImageTool{
+		name:        "wl-clipboard",
+		executables: []string{"wl-paste", "wl-copy"},
+		listTypes: func() *exec.Cmd {
+			return exec.Command("wl-paste", "--list-types")
+		},
+		read: func(mime string) *exec.Cmd {
+			return exec.Command("wl-paste", "--no-newline", "--type", mime)
+		},
+		write: func(mime string) *exec.Cmd {
+			return exec.Command("wl-copy", "--type", mime)
+		},
+	}
+	xclip = clipboardImageTool{
+		name:        "xclip",
+		executables: []string{"xclip"},
+		listTypes: func() *exec.Cmd {
+			return exec.Command("xclip", "-selection", "clipboard", "-target", "TARGETS", "-out")
+		},
+		read: func(mime string) *exec.Cmd {
+			return exec.Command("xclip", "-selection", "clipboard", "-target", mime, "-out")
+		},
+		write: func(mime string) *exec.Cmd {
+			return exec.Command("xclip", "-selection", "clipboard", "-target", mime, "-in")
+		},
+	}
+)
+
+// findClipboardImageTool returns wl-clipboard in a Wayland session and xclip on X11. The other one
+// is used if the preferred one isn't installed, EG: xclip with XWayland
+func findClipboardImageTool() (clipboardImageTool, error) {
+	tools := []clipboardImageTool{xclip, wlClipboard}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		tools = []clipboardImageTool{wlClipboard, xclip}
+	}
+	for _, tool := range tools {
+		if tool.installed() {
+			return tool, nil
+		}
+	}
+	return clipboardImageTool{}, fmt.Errorf("clipboard images need %s or %s to be installed", tools[0].name, tools[1].name)
+}
+
+func (t clipboardImageTool) installed() bool {
+	for _, executable := range t.executables {
+		if _, err := exec.LookPath(executable); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ClipboardGetImage returns the image on the clipboard and its MIME type, "image/png" is preferred.
+// frontend.ErrClipboardEmpty is returned if there is no image
+func (f *Frontend) ClipboardGetImage() ([]byte, string, error) {
+	tool, err := findClipboardImageTool()
+	if err != nil {
+		return nil, "", err
+	}
+
+	types, err := tool.listTypes().Output()
+	if err != nil {
+		// Both tools fail when the clipboard is empty
+		return nil, "", frontend.ErrClipboardEmpty
+	}
+	var mime string
+	for _, line := range strings.Split(string(types), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "image/png" {
+			mime = line
+			break
+		}
+		if mime == "" && strings.HasPrefix(line, "image/") {
+			mime = line
+		}
+	}
+	if mime == "" {
+		return nil, "", frontend.ErrClipboardEmpty
+	}
+
+	var stderr bytes.Buffer
+	cmd := tool.read(mime)
+	cmd.Stderr = &stderr
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read the image from the clipboard with %s: %w %s", tool.name, err, strings.TrimSpace(stderr.String()))
+	}
+	if len(data) == 0 {
+		return nil, "", frontend.ErrClipboardEmpty
+	}
+	return data, mime, nil
+}
+
+// ClipboardSetImage writes the image to the clipboard. If mime is empty it's detected from the data
+func (f *Frontend) ClipboardSetImage(data []byte, mime string) error {
+	if len(data) == 0 {
+		return errors.New("no image data")
+	}
+	if mime == "" {
+		mime = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(mime, "image/") {
+		return errors.New("unable to write the data of type '" + mime + "' to the clipboard as image")
+	}
+
+	tool, err := findClipboardImageTool()
+	if err != nil {
+		return err
+	}
+
+	// Both tools keep running in the background to serve the clipboard, so their output must not be captured.
+	// Otherwise Run waits until another application takes over the clipboard
+	cmd := tool.write(mime)
+	cmd.Stdin = bytes.NewReader(data)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("unable to write the image to the clipboard with %s: %w", tool.name, err)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import "errors"
+
+func (f *Frontend) SetContentRules(rules string) error {
+	return errors.New("content rules are only supported on macOS")
+}
+
+func (f *Frontend) ClearContentRules() {}
@@ -0,0 +1,16 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) FindInPage(query string, options frontend.FindOptions) (int, error) {
+	return 0, errors.New("find in page is only supported on macOS")
+}
+
+func (f *Frontend) FindStopSession() {}
@@ -87,7 +87,8 @@ import (
 	"runtime"
 	"strings"
 	"sync"
-	"text/template"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
@@ -127,12 +128,36 @@ type Frontend struct {
 	dispatcher frontend.Dispatcher
 
 	originValidator *originvalidator.OriginValidator
+
+	idleMonitor *frontend.IdleMonitor
+
+	// CSS injected by InjectCSS, applied again when the page reloads
+	styleSheets frontend.StyleSheets
+
+	// Set by QuitWithCode
+	exitCode atomic.Int32
+
+	// Throttling state of NotifyThrottled
+	notifyThrottle frontend.NotifyThrottle
+	execJSRecorder frontend.ExecJSRecorder
+
+	// Stopped when the runtime of the frontend is ready
+	startupWatchdog *frontend.StartupWatchdog
+
+	// Logs the time until the frontend is ready in dev mode
+	startupTimer *frontend.StartupTimer
 }
 
 func (f *Frontend) RunMainLoop() {
+	mainLoopWatchdog := frontend.StartMainLoopWatchdog(f.debug, f.frontendOptions, f.logger, invokeOnMainThread)
+	defer mainLoopWatchdog.Stop()
 	C.gtk_main()
 }
 
+func (f *Frontend) RunOnMainThread(fn func()) {
+	frontend.RunOnMainThread(invokeOnMainThread, fn)
+}
+
 func (f *Frontend) WindowClose() {
 	f.mainWindow.Destroy()
 }
@@ -157,6 +182,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		bindings:        appBindings,
 		dispatcher:      dispatcher,
 		ctx:             ctx,
+		idleMonitor:     frontend.NewIdleMonitor(ctx),
 	}
 	result.startURL, _ = url.Parse(startURL)
 	result.originValidator = originvalidator.NewOriginValidator(result.startURL, appoptions.BindingsAllowedOrigins)
@@ -218,12 +244,14 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 }
 
 func (f *Frontend) startMessageProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "message processor")
 	for message := range messageBuffer {
 		f.processMessage(message)
 	}
 }
 
 func (f *Frontend) startBindingsMessageProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "bindings message processor")
 	for msg := range bindingsMessageBuffer {
 		origin, err := f.originValidator.GetOriginFromURL(msg.source)
 		if err != nil {
@@ -242,6 +270,7 @@ func (f *Frontend) startBindingsMessageProcessor() {
 }
 
 func (f *Frontend) WindowReload() {
+	f.startupTimer.Reloading()
 	f.ExecJS("runtime.WindowReload();")
 }
 
@@ -258,9 +287,14 @@ func (f *Frontend) WindowSetDarkTheme() {
 }
 
 func (f *Frontend) Run(ctx context.Context) error {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "startup")
 	f.ctx = ctx
 
+	f.startupWatchdog = frontend.StartStartupWatchdog(f, f.frontendOptions, f.logger, f.startURL.String())
+	f.startupTimer = frontend.NewStartupTimer(f.ctx, f.logger)
+
 	go func() {
+		defer frontend.ReportCrash(f.logger, f.frontendOptions, "OnStartup")
 		if f.frontendOptions.OnStartup != nil {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
@@ -283,6 +317,14 @@ func (f *Frontend) WindowSetAlwaysOnTop(b bool) {
 	f.mainWindow.SetKeepAbove(b)
 }
 
+func (f *Frontend) WindowSetMinimizable(b bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetMaximizable(b bool) {
+	// Not supported on Linux
+}
+
 func (f *Frontend) WindowSetPosition(x, y int) {
 	f.mainWindow.SetPosition(x, y)
 }
@@ -302,6 +344,22 @@ func (f *Frontend) WindowSetTitle(title string) {
 	f.mainWindow.SetTitle(title)
 }
 
+func (f *Frontend) WindowGetTitle() string {
+	return f.mainWindow.Title()
+}
+
+func (f *Frontend) WindowSetIcon(data []byte) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowRequestUserAttention(critical bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) DockSetBadge(label string) {
+	// Not supported on Linux
+}
+
 func (f *Frontend) WindowFullscreen() {
 	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
 		f.ExecJS("window.wails.flags.enableResize = false;")
@@ -317,6 +375,7 @@ func (f *Frontend) WindowUnfullscreen() {
 }
 
 func (f *Frontend) WindowReloadApp() {
+	f.startupTimer.Reloading()
 	f.ExecJS(fmt.Sprintf("window.location.href = '%s';", f.startURL))
 }
 
@@ -338,6 +397,10 @@ func (f *Frontend) Hide() {
 func (f *Frontend) WindowMaximise() {
 	f.mainWindow.Maximise()
 }
+func (f *Frontend) WindowShowZoomMenu() {
+	// Not supported on Linux
+}
+
 func (f *Frontend) WindowToggleMaximise() {
 	f.mainWindow.ToggleMaximise()
 }
@@ -358,6 +421,14 @@ func (f *Frontend) WindowSetMaxSize(width int, height int) {
 	f.mainWindow.SetMaxSize(width, height)
 }
 
+func (f *Frontend) WindowSetMinSizeFraction(widthFraction float64, heightFraction float64) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetMaxSizeFraction(widthFraction float64, heightFraction float64) {
+	// Not supported on Linux
+}
+
 func (f *Frontend) WindowSetBackgroundColour(col *options.RGBA) {
 	if col == nil {
 		return
@@ -365,10 +436,24 @@ func (f *Frontend) WindowSetBackgroundColour(col *options.RGBA) {
 	f.mainWindow.SetBackgroundColour(col.R, col.G, col.B, col.A)
 }
 
+// WebViewSetBackgroundColour is the same as WindowSetBackgroundColour on Linux as the
+// window background is already applied to the webview.
+func (f *Frontend) WebViewSetBackgroundColour(col *options.RGBA) {
+	f.WindowSetBackgroundColour(col)
+}
+
 func (f *Frontend) ScreenGetAll() ([]Screen, error) {
 	return GetAllScreens(f.mainWindow.asGTKWindow())
 }
 
+func (f *Frontend) WindowGetCurrentScreen() (*Screen, error) {
+	return GetCurrentScreen(f.mainWindow.asGTKWindow())
+}
+
+func (f *Frontend) TrimMemory() {
+	// Not supported on Linux
+}
+
 func (f *Frontend) WindowIsMaximised() bool {
 	return f.mainWindow.IsMaximised()
 }
@@ -386,37 +471,110 @@ func (f *Frontend) WindowIsFullscreen() bool {
 }
 
 func (f *Frontend) Quit() {
+	f.QuitWithCode(0)
+}
+
+func (f *Frontend) QuitWithCode(code int) {
 	if f.frontendOptions.OnBeforeClose != nil {
 		go func() {
 			if !f.frontendOptions.OnBeforeClose(f.ctx) {
+				f.exitCode.Store(int32(code))
 				f.mainWindow.Quit()
 			}
 		}()
 		return
 	}
+	f.exitCode.Store(int32(code))
 	f.mainWindow.Quit()
 }
 
+func (f *Frontend) ExitCode() int {
+	return int(f.exitCode.Load())
+}
+
 func (f *Frontend) WindowPrint() {
 	f.ExecJS("window.print();")
 }
 
-type EventNotify struct {
-	Name string        `json:"name"`
-	Data []interface{} `json:"data"`
+func (f *Frontend) WindowOpenDevTools() {
+	if !f.devtoolsEnabled {
+		f.logger.Debug("Not opening the devtools, they are not enabled")
+		return
+	}
+	f.mainWindow.ShowInspector()
 }
 
-func (f *Frontend) Notify(name string, data ...interface{}) {
-	notification := EventNotify{
-		Name: name,
-		Data: data,
+func (f *Frontend) WindowCloseDevTools() {
+	if !f.devtoolsEnabled {
+		f.logger.Debug("Not closing the devtools, they are not enabled")
+		return
 	}
-	payload, err := json.Marshal(notification)
+	f.mainWindow.CloseInspector()
+}
+
+func (f *Frontend) WindowSetCollectionBehavior(flags []string) error {
+	return errors.New("window collection behaviour is only supported on macOS")
+}
+
+func (f *Frontend) WindowSetSpellCheckEnabled(enabled bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetSpellCheckLanguage(language string) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetEnabled(enabled bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetJavaScriptEnabled(enabled bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetShadow(enabled bool) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowSetCornerRadius(radius float64) {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowBack() {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowForward() {
+	// Not supported on Linux
+}
+
+func (f *Frontend) WindowCanGoBack() bool {
+	// Not supported on Linux
+	return false
+}
+
+func (f *Frontend) WindowCanGoForward() bool {
+	// Not supported on Linux
+	return false
+}
+
+func (f *Frontend) WindowStopLoading() {
+	// Not supported on Linux
+}
+
+func (f *Frontend) Notify(name string, data ...interface{}) {
+	js, err := frontend.EventNotifyJS(f.frontendOptions.EventEncoding, name, data)
 	if err != nil {
 		f.logger.Error(err.Error())
 		return
 	}
-	f.mainWindow.ExecJS(`window.wails.EventsNotify('` + template.JSEscapeString(string(payload)) + `');`)
+	f.ExecJS(js)
+}
+
+// NotifyThrottled notifies the frontend of the event at most once per minInterval for each event name.
+// The latest dropped event is sent when the interval ends
+func (f *Frontend) NotifyThrottled(name string, minInterval time.Duration, data ...interface{}) {
+	f.notifyThrottle.Notify(name, minInterval, data, f.Notify)
 }
 
 var edgeMap = map[string]uintptr{
@@ -432,6 +590,7 @@ var edgeMap = map[string]uintptr{
 
 func (f *Frontend) processMessage(message string) {
 	if message == "DomReady" {
+		f.startupTimer.Ready(message)
 		if f.frontendOptions.OnDomReady != nil {
 			f.frontendOptions.OnDomReady(f.ctx)
 		}
@@ -445,6 +604,16 @@ func (f *Frontend) processMessage(message string) {
 		return
 	}
 
+	if message == frontend.IdleActivityMessage {
+		f.idleMonitor.Activity()
+		return
+	}
+
+	if strings.HasPrefix(message, frontend.SimulatedEventPrefix) {
+		f.processSimulatedEvent(message)
+		return
+	}
+
 	if message == "wails:showInspector" {
 		f.mainWindow.ShowInspector()
 		return
@@ -467,6 +636,9 @@ func (f *Frontend) processMessage(message string) {
 	}
 
 	if message == "runtime:ready" {
+		f.startupWatchdog.Ready()
+		f.startupTimer.Ready(message)
+
 		cmd := fmt.Sprintf(
 			"window.wails.setCSSDragProperties('%s', '%s');\n"+
 				"window.wails.setCSSDropProperties('%s', '%s');\n"+
@@ -487,6 +659,9 @@ func (f *Frontend) processMessage(message string) {
 			f.ExecJS("window.wails.flags.enableWailsDragAndDrop = true;")
 		}
 
+		f.applyIdleMonitor()
+		f.applyStyleSheets()
+
 		return
 	}
 
@@ -529,9 +704,28 @@ func (f *Frontend) startResize(edge uintptr) error {
 }
 
 func (f *Frontend) ExecJS(js string) {
+	if f.execJSRecorder.Record(js) {
+		return
+	}
 	f.mainWindow.ExecJS(js)
 }
 
+// ExecJSRecordingStart records the JS sent to the webview, including events and callbacks. If passthrough is
+// false the JS isn't executed
+func (f *Frontend) ExecJSRecordingStart(passthrough bool) error {
+	return f.execJSRecorder.Start(passthrough)
+}
+
+// ExecJSRecordingDrain returns the recorded JS and clears the buffer
+func (f *Frontend) ExecJSRecordingDrain() []string {
+	return f.execJSRecorder.Drain()
+}
+
+// ExecJSRecordingStop stops recording and returns the JS which hasn't been drained
+func (f *Frontend) ExecJSRecordingStop() []string {
+	return f.execJSRecorder.Stop()
+}
+
 type bindingsMessage struct {
 	message string
 	source  string
@@ -559,6 +753,7 @@ func processBindingMessage(message *C.char, source *C.char) {
 var requestBuffer = make(chan webview.Request, 100)
 
 func (f *Frontend) startRequestProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "request processor")
 	for request := range requestBuffer {
 		f.assets.ServeWebViewRequest(request)
 	}
@@ -570,6 +765,7 @@ func processURLRequest(request unsafe.Pointer) {
 }
 
 func (f *Frontend) startSecondInstanceProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "second instance processor")
 	for secondInstanceData := range secondInstanceBuffer {
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
@@ -0,0 +1,24 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// SetIdleTimeout calls the callback and emits "wails:app:idle" when there has been no user input
+// in the webview for the given timeout. A timeout of 0 disables it
+func (f *Frontend) SetIdleTimeout(timeout time.Duration, callback func()) {
+	f.idleMonitor.SetTimeout(timeout, callback)
+	f.applyIdleMonitor()
+}
+
+// applyIdleMonitor injects the activity listener into the page if an idle timeout is set
+func (f *Frontend) applyIdleMonitor() {
+	if f.idleMonitor.Enabled() {
+		f.ExecJS(frontend.IdleActivityScript)
+	}
+}
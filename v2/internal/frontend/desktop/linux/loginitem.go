@@ -0,0 +1,14 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import "errors"
+
+func (f *Frontend) SetLaunchAtLogin(enabled bool) error {
+	return errors.New("launch at login is only supported on macOS")
+}
+
+func (f *Frontend) GetLaunchAtLogin() (bool, error) {
+	return false, errors.New("launch at login is only supported on macOS")
+}
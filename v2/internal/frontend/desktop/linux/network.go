@@ -0,0 +1,14 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) GetNetworkStatus() (frontend.NetworkStatus, error) {
+	return frontend.NetworkStatus{}, errors.New("network status is only supported on macOS")
+}
@@ -10,6 +10,7 @@ package linux
 
 #cgo CFLAGS: -w
 #include <stdio.h>
+#include <string.h>
 #include "webkit2/webkit2.h"
 #include "gtk/gtk.h"
 #include "gdk/gdk.h"
@@ -43,6 +44,28 @@ Screen GetNThMonitor(int monitor_num, GtkWindow *window){
 	screen.scale = gdk_monitor_get_scale_factor(monitor);
 	return screen;
 }
+
+// GetCurrentMonitorIndex returns the index of the monitor which contains the center of the window
+int GetCurrentMonitorIndex(GtkWindow *window){
+	GdkWindow *gdk_window = gtk_widget_get_window(GTK_WIDGET(window));
+	GdkDisplay *display = gdk_window_get_display(gdk_window);
+	GdkMonitor *current;
+	if (strcmp(G_OBJECT_TYPE_NAME(display), "GdkWaylandDisplay") == 0) {
+		// The position of windows isn't known on Wayland, use the monitor with the largest part of the window
+		current = gdk_display_get_monitor_at_window(display, gdk_window);
+	} else {
+		GdkRectangle frame;
+		gdk_window_get_frame_extents(gdk_window, &frame);
+		current = gdk_display_get_monitor_at_point(display, frame.x + frame.width / 2, frame.y + frame.height / 2);
+	}
+	int n = gdk_display_get_n_monitors(display);
+	for (int i = 0; i < n; i++) {
+		if (gdk_display_get_monitor(display, i) == current) {
+			return i;
+		}
+	}
+	return 0;
+}
 */
 import "C"
 import (
@@ -65,23 +88,7 @@ func GetAllScreens(window *C.GtkWindow) ([]Screen, error) {
 		numMonitors := C.GetNMonitors(window)
 		for i := 0; i < int(numMonitors); i++ {
 			cMonitor := C.GetNThMonitor(C.int(i), window)
-
-			screen := Screen{
-				IsCurrent: cMonitor.isCurrent == 1,
-				IsPrimary: cMonitor.isPrimary == 1,
-				Width:     int(cMonitor.width),
-				Height:    int(cMonitor.height),
-
-				Size: frontend.ScreenSize{
-					Width:  int(cMonitor.width),
-					Height: int(cMonitor.height),
-				},
-				PhysicalSize: frontend.ScreenSize{
-					Width:  int(cMonitor.width * cMonitor.scale),
-					Height: int(cMonitor.height * cMonitor.scale),
-				},
-			}
-			screens = append(screens, screen)
+			screens = append(screens, screenFromC(cMonitor))
 		}
 
 		wg.Done()
@@ -89,3 +96,47 @@ func GetAllScreens(window *C.GtkWindow) ([]Screen, error) {
 	wg.Wait()
 	return screens, nil
 }
+
+// GetCurrentScreen returns the screen which contains the center of the window
+func GetCurrentScreen(window *C.GtkWindow) (*Screen, error) {
+	if window == nil {
+		return nil, errors.New("window is nil, cannot perform screen operations")
+	}
+	var wg sync.WaitGroup
+	var screen Screen
+	var err error
+	wg.Add(1)
+	invokeOnMainThread(func() {
+		defer wg.Done()
+		if C.GetNMonitors(window) == 0 {
+			err = errors.New("no screens found")
+			return
+		}
+		screen = screenFromC(C.GetNThMonitor(C.GetCurrentMonitorIndex(window), window))
+		screen.IsCurrent = true
+	})
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return &screen, nil
+}
+
+func screenFromC(cMonitor C.Screen) Screen {
+	return Screen{
+		IsCurrent: cMonitor.isCurrent == 1,
+		IsPrimary: cMonitor.isPrimary == 1,
+		Width:     int(cMonitor.width),
+		Height:    int(cMonitor.height),
+
+		Size: frontend.ScreenSize{
+			Width:  int(cMonitor.width),
+			Height: int(cMonitor.height),
+		},
+		PhysicalSize: frontend.ScreenSize{
+			Width:  int(cMonitor.width * cMonitor.scale),
+			Height: int(cMonitor.height * cMonitor.scale),
+		},
+		ScaleFactor: float64(cMonitor.scale),
+	}
+}
@@ -0,0 +1,10 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import "errors"
+
+func (f *Frontend) GetSelectedText() (string, error) {
+	return "", errors.New("getting the selected text is only supported on macOS")
+}
@@ -0,0 +1,36 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// processSimulatedEvent feeds a synthetic OS event from the frontend into the same buffers
+// the native callbacks use, so the app's handlers can be tested end-to-end
+func (f *Frontend) processSimulatedEvent(message string) {
+	if !f.debug {
+		f.logger.Error("Simulated events are only available in debug builds")
+		return
+	}
+
+	event, err := frontend.ParseSimulatedEvent(message)
+	if err != nil {
+		f.logger.Error(err.Error())
+		return
+	}
+
+	switch event.Type {
+	case frontend.SimulatedSecondInstance:
+		secondInstanceBuffer <- event.SecondInstanceData()
+	case frontend.SimulatedThemeChange:
+		if err := frontend.SimulateThemeChange(f, event.Theme); err != nil {
+			f.logger.Error(err.Error())
+		}
+	case frontend.SimulatedFileOpen, frontend.SimulatedURLOpen:
+		f.logger.Error("Simulated '%s' events are only supported on macOS", event.Type)
+	default:
+		f.logger.Error("Unknown simulated event type '%s'", event.Type)
+	}
+}
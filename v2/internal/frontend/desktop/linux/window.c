@@ -877,6 +877,11 @@ void ShowInspector(void *webview) {
     webkit_web_inspector_show(WEBKIT_WEB_INSPECTOR(inspector));
 }
 
+void CloseInspector(void *webview) {
+    WebKitWebInspector *inspector = webkit_web_view_get_inspector(WEBKIT_WEB_VIEW(webview));
+    webkit_web_inspector_close(WEBKIT_WEB_INSPECTOR(inspector));
+}
+
 void sendShowInspectorMessage() {
     processMessage("wails:showInspector");
 }
@@ -356,6 +356,18 @@ func (w *Window) SetTitle(title string) {
 	C.SetTitle(w.asGTKWindow(), C.CString(title))
 }
 
+func (w *Window) Title() string {
+	var title string
+	var wg sync.WaitGroup
+	wg.Add(1)
+	invokeOnMainThread(func() {
+		title = C.GoString((*C.char)(unsafe.Pointer(C.gtk_window_get_title(w.asGTKWindow()))))
+		wg.Done()
+	})
+	wg.Wait()
+	return title
+}
+
 func (w *Window) ExecJS(js string) {
 	jscallback := C.JSCallback{
 		webview: w.webview,
@@ -462,6 +474,10 @@ func (w *Window) ShowInspector() {
 	invokeOnMainThread(func() { C.ShowInspector(w.webview) })
 }
 
+func (w *Window) CloseInspector() {
+	invokeOnMainThread(func() { C.CloseInspector(w.webview) })
+}
+
 // showModalDialogAndExit shows a modal dialog and exits the app.
 func showModalDialogAndExit(title, message string) {
 	go func() {
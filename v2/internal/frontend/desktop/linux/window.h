@@ -123,6 +123,7 @@ void Opendialog(void *data);
 // Inspector
 void sendShowInspectorMessage();
 void ShowInspector(void *webview);
+void CloseInspector(void *webview);
 void InstallF12Hotkey(void *window);
 
 #endif /* window_h */
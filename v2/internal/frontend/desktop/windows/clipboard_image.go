@@ -0,0 +1,40 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+)
+
+// ClipboardGetImage returns the image on the clipboard as "image/png", bitmaps are converted to PNG.
+// frontend.ErrClipboardEmpty is returned if there is no image
+func (f *Frontend) ClipboardGetImage() ([]byte, string, error) {
+	data, err := win32.GetClipboardImage()
+	if err != nil {
+		return nil, "", err
+	}
+	if data == nil {
+		return nil, "", frontend.ErrClipboardEmpty
+	}
+	return data, "image/png", nil
+}
+
+// ClipboardSetImage writes the image to the clipboard. If mime is empty it's detected from the data.
+// Only PNG images are supported
+func (f *Frontend) ClipboardSetImage(data []byte, mime string) error {
+	if len(data) == 0 {
+		return errors.New("no image data")
+	}
+	if mime == "" {
+		mime = http.DetectContentType(data)
+	}
+	if mime != "image/png" {
+		return errors.New("unable to write the image of type '" + mime + "' to the clipboard, only image/png is supported")
+	}
+	return win32.SetClipboardImage(data)
+}
@@ -0,0 +1,12 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import "errors"
+
+func (f *Frontend) SetContentRules(rules string) error {
+	return errors.New("content rules are only supported on macOS")
+}
+
+func (f *Frontend) ClearContentRules() {}
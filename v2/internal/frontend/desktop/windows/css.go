@@ -0,0 +1,26 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// InjectCSS adds or replaces the stylesheet with the given id. It is applied again when the page reloads
+func (f *Frontend) InjectCSS(id string, css string) {
+	f.styleSheets.Set(id, css)
+	f.ExecJS(frontend.InjectCSSJS(id, css))
+}
+
+// RemoveCSS removes the stylesheet with the given id
+func (f *Frontend) RemoveCSS(id string) {
+	f.styleSheets.Remove(id)
+	f.ExecJS(frontend.RemoveCSSJS(id))
+}
+
+func (f *Frontend) applyStyleSheets() {
+	if js := f.styleSheets.JS(); js != "" {
+		f.ExecJS(js)
+	}
+}
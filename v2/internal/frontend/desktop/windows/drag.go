@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) StartDrag(items []frontend.DragItem) error {
+	return errors.New("dragging items out of the application is only supported on macOS")
+}
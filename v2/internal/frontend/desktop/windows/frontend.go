@@ -6,6 +6,7 @@ package windows
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -14,7 +15,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
-	"text/template"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -70,6 +71,27 @@ type Frontend struct {
 	// Windows build number
 	versionInfo     *operatingsystem.WindowsVersionInfo
 	resizeDebouncer func(f func())
+
+	idleMonitor *frontend.IdleMonitor
+
+	// CSS injected by InjectCSS, applied again when the page reloads
+	styleSheets frontend.StyleSheets
+
+	// Set by QuitWithCode
+	exitCode atomic.Int32
+
+	// Throttling state of NotifyThrottled
+	notifyThrottle frontend.NotifyThrottle
+	execJSRecorder frontend.ExecJSRecorder
+
+	// Stopped when the runtime of the frontend is ready
+	startupWatchdog *frontend.StartupWatchdog
+
+	// Logs the time until the frontend is ready in dev mode
+	startupTimer *frontend.StartupTimer
+
+	// Whether the window isn't the active one, restored in the document after reloads
+	windowInactive bool
 }
 
 func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.Logger, appBindings *binding.Bindings, dispatcher frontend.Dispatcher) *Frontend {
@@ -91,6 +113,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		dispatcher:      dispatcher,
 		ctx:             ctx,
 		versionInfo:     versionInfo,
+		idleMonitor:     frontend.NewIdleMonitor(ctx),
 	}
 
 	if appoptions.Windows != nil {
@@ -137,6 +160,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 }
 
 func (f *Frontend) WindowReload() {
+	f.startupTimer.Reloading()
 	f.ExecJS("runtime.WindowReload();")
 }
 
@@ -153,6 +177,7 @@ func (f *Frontend) WindowSetDarkTheme() {
 }
 
 func (f *Frontend) Run(ctx context.Context) error {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "startup")
 	f.ctx = ctx
 
 	f.chromium = edge.NewChromium()
@@ -162,6 +187,7 @@ func (f *Frontend) Run(ctx context.Context) error {
 	}
 
 	mainWindow := NewWindow(nil, f.frontendOptions, f.versionInfo, f.chromium)
+	mainWindow.OnActiveChanged = f.windowActiveChanged
 	f.mainWindow = mainWindow
 
 	var _debug = ctx.Value("debug")
@@ -219,7 +245,11 @@ func (f *Frontend) Run(ctx context.Context) error {
 		}
 	})
 
+	f.startupWatchdog = frontend.StartStartupWatchdog(f, f.frontendOptions, f.logger, f.startURL.String())
+	f.startupTimer = frontend.NewStartupTimer(f.ctx, f.logger)
+
 	go func() {
+		defer frontend.ReportCrash(f.logger, f.frontendOptions, "OnStartup")
 		if f.frontendOptions.OnStartup != nil {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
@@ -235,9 +265,15 @@ func (f *Frontend) WindowClose() {
 }
 
 func (f *Frontend) RunMainLoop() {
+	mainLoopWatchdog := frontend.StartMainLoopWatchdog(f.debug, f.frontendOptions, f.logger, f.mainWindow.Invoke)
+	defer mainLoopWatchdog.Stop()
 	_ = winc.RunMainLoop()
 }
 
+func (f *Frontend) RunOnMainThread(fn func()) {
+	frontend.RunOnMainThread(f.mainWindow.Invoke, fn)
+}
+
 func (f *Frontend) WindowCenter() {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -250,6 +286,18 @@ func (f *Frontend) WindowSetAlwaysOnTop(b bool) {
 	f.mainWindow.SetAlwaysOnTop(b)
 }
 
+func (f *Frontend) WindowSetMinimizable(b bool) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	f.mainWindow.SetMinimizable(b)
+}
+
+func (f *Frontend) WindowSetMaximizable(b bool) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	f.mainWindow.SetMaximizable(b)
+}
+
 func (f *Frontend) WindowSetPosition(x, y int) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -279,6 +327,18 @@ func (f *Frontend) WindowSetTitle(title string) {
 	f.mainWindow.SetText(title)
 }
 
+func (f *Frontend) WindowGetTitle() string {
+	return f.mainWindow.Text()
+}
+
+func (f *Frontend) WindowSetIcon(data []byte) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) DockSetBadge(label string) {
+	// Not supported on Windows
+}
+
 func (f *Frontend) WindowFullscreen() {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -289,6 +349,7 @@ func (f *Frontend) WindowFullscreen() {
 }
 
 func (f *Frontend) WindowReloadApp() {
+	f.startupTimer.Reloading()
 	f.ExecJS(fmt.Sprintf("window.location.href = '%s';", f.startURL))
 }
 
@@ -325,6 +386,16 @@ func (f *Frontend) WindowMaximise() {
 	}
 }
 
+func (f *Frontend) WindowRequestUserAttention(critical bool) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	f.mainWindow.RequestUserAttention(critical)
+}
+
+func (f *Frontend) WindowShowZoomMenu() {
+	// Not supported on Windows
+}
+
 func (f *Frontend) WindowToggleMaximise() {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -377,6 +448,14 @@ func (f *Frontend) WindowSetMaxSize(width int, height int) {
 	f.mainWindow.SetMaxSize(width, height)
 }
 
+func (f *Frontend) WindowSetMinSizeFraction(widthFraction float64, heightFraction float64) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetMaxSizeFraction(widthFraction float64, heightFraction float64) {
+	// Not supported on Windows
+}
+
 func (f *Frontend) WindowSetBackgroundColour(col *options.RGBA) {
 	if col == nil {
 		return
@@ -412,6 +491,12 @@ func (f *Frontend) WindowSetBackgroundColour(col *options.RGBA) {
 
 }
 
+// WebViewSetBackgroundColour is the same as WindowSetBackgroundColour on Windows as the
+// window background is already applied to the webview.
+func (f *Frontend) WebViewSetBackgroundColour(col *options.RGBA) {
+	f.WindowSetBackgroundColour(col)
+}
+
 func (f *Frontend) ScreenGetAll() ([]Screen, error) {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -426,6 +511,23 @@ func (f *Frontend) ScreenGetAll() ([]Screen, error) {
 	return screens, err
 }
 
+func (f *Frontend) WindowGetCurrentScreen() (*Screen, error) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	var screen *Screen
+	var err error
+	f.mainWindow.Invoke(func() {
+		screen, err = GetCurrentScreen(f.mainWindow.Handle())
+		wg.Done()
+	})
+	wg.Wait()
+	return screen, err
+}
+
+func (f *Frontend) TrimMemory() {
+	// Not supported on Windows
+}
+
 func (f *Frontend) Show() {
 	f.mainWindow.Show()
 }
@@ -451,9 +553,18 @@ func (f *Frontend) WindowIsFullscreen() bool {
 }
 
 func (f *Frontend) Quit() {
+	f.QuitWithCode(0)
+}
+
+func (f *Frontend) ExitCode() int {
+	return int(f.exitCode.Load())
+}
+
+func (f *Frontend) QuitWithCode(code int) {
 	if f.frontendOptions.OnBeforeClose != nil && f.frontendOptions.OnBeforeClose(f.ctx) {
 		return
 	}
+	f.exitCode.Store(int32(code))
 	// Exit must be called on the Main-Thread. It calls PostQuitMessage which sends the WM_QUIT message to the thread's
 	// message queue and our message queue runs on the Main-Thread.
 	f.mainWindow.Invoke(winc.Exit)
@@ -463,6 +574,69 @@ func (f *Frontend) WindowPrint() {
 	f.ExecJS("window.print();")
 }
 
+func (f *Frontend) WindowOpenDevTools() {
+	if !f.devtoolsEnabled {
+		f.logger.Debug("Not opening the devtools, they are not enabled")
+		return
+	}
+	f.mainWindow.Invoke(f.chromium.OpenDevToolsWindow)
+}
+
+func (f *Frontend) WindowCloseDevTools() {
+	// WebView2 has got no API to close the devtools window
+	f.logger.Debug("Closing the devtools is not supported on Windows")
+}
+
+func (f *Frontend) WindowSetCollectionBehavior(flags []string) error {
+	return errors.New("window collection behaviour is only supported on macOS")
+}
+
+func (f *Frontend) WindowSetSpellCheckEnabled(enabled bool) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetSpellCheckLanguage(language string) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetEnabled(enabled bool) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetJavaScriptEnabled(enabled bool) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetShadow(enabled bool) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowSetCornerRadius(radius float64) {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowBack() {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowForward() {
+	// Not supported on Windows
+}
+
+func (f *Frontend) WindowCanGoBack() bool {
+	// Not supported on Windows
+	return false
+}
+
+func (f *Frontend) WindowCanGoForward() bool {
+	// Not supported on Windows
+	return false
+}
+
+func (f *Frontend) WindowStopLoading() {
+	// Not supported on Windows
+}
+
 func (f *Frontend) setupChromium() {
 	chromium := f.chromium
 
@@ -608,22 +782,19 @@ func (f *Frontend) setupChromium() {
 	chromium.Navigate(f.startURL.String())
 }
 
-type EventNotify struct {
-	Name string        `json:"name"`
-	Data []interface{} `json:"data"`
-}
-
 func (f *Frontend) Notify(name string, data ...interface{}) {
-	notification := EventNotify{
-		Name: name,
-		Data: data,
-	}
-	payload, err := json.Marshal(notification)
+	js, err := frontend.EventNotifyJS(f.frontendOptions.EventEncoding, name, data)
 	if err != nil {
 		f.logger.Error(err.Error())
 		return
 	}
-	f.ExecJS(`window.wails.EventsNotify('` + template.JSEscapeString(string(payload)) + `');`)
+	f.ExecJS(js)
+}
+
+// NotifyThrottled notifies the frontend of the event at most once per minInterval for each event name.
+// The latest dropped event is sent when the interval ends
+func (f *Frontend) NotifyThrottled(name string, minInterval time.Duration, data ...interface{}) {
+	f.notifyThrottle.Notify(name, minInterval, data, f.Notify)
 }
 
 func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
@@ -713,6 +884,16 @@ func (f *Frontend) processMessage(message string, sender *edge.ICoreWebView2, ar
 		return
 	}
 
+	if message == frontend.IdleActivityMessage {
+		f.idleMonitor.Activity()
+		return
+	}
+
+	if strings.HasPrefix(message, frontend.SimulatedEventPrefix) {
+		f.processSimulatedEvent(message)
+		return
+	}
+
 	if message == "drag" {
 		if !f.mainWindow.IsFullScreen() {
 			err := f.startDrag()
@@ -724,6 +905,9 @@ func (f *Frontend) processMessage(message string, sender *edge.ICoreWebView2, ar
 	}
 
 	if message == "runtime:ready" {
+		f.startupWatchdog.Ready()
+		f.startupTimer.Ready(message)
+
 		cmd := fmt.Sprintf(
 			"window.wails.setCSSDragProperties('%s', '%s');\n"+
 				"window.wails.setCSSDropProperties('%s', '%s');",
@@ -734,6 +918,11 @@ func (f *Frontend) processMessage(message string, sender *edge.ICoreWebView2, ar
 		)
 
 		f.ExecJS(cmd)
+		f.applyIdleMonitor()
+		if f.windowInactive {
+			f.ExecJS(frontend.WindowActiveJS(false))
+		}
+		f.applyStyleSheets()
 		return
 	}
 
@@ -871,9 +1060,7 @@ func (f *Frontend) Callback(message string) {
 	if err != nil {
 		panic(err)
 	}
-	f.mainWindow.Invoke(func() {
-		f.chromium.Eval(`window.wails.Callback(` + conv.BytesToString(escaped) + `);`)
-	})
+	f.ExecJS(`window.wails.Callback(` + conv.BytesToString(escaped) + `);`)
 }
 
 func (f *Frontend) startDrag() error {
@@ -895,12 +1082,46 @@ func (f *Frontend) startResize(border uintptr) error {
 }
 
 func (f *Frontend) ExecJS(js string) {
+	if f.execJSRecorder.Record(js) {
+		return
+	}
 	f.mainWindow.Invoke(func() {
 		f.chromium.Eval(js)
 	})
 }
 
+// ExecJSRecordingStart records the JS sent to the webview, including events and callbacks. If passthrough is
+// false the JS isn't executed
+func (f *Frontend) ExecJSRecordingStart(passthrough bool) error {
+	return f.execJSRecorder.Start(passthrough)
+}
+
+// ExecJSRecordingDrain returns the recorded JS and clears the buffer
+func (f *Frontend) ExecJSRecordingDrain() []string {
+	return f.execJSRecorder.Drain()
+}
+
+// ExecJSRecordingStop stops recording and returns the JS which hasn't been drained
+func (f *Frontend) ExecJSRecordingStop() []string {
+	return f.execJSRecorder.Stop()
+}
+
+// windowActiveChanged emits "wails:window:active" or "wails:window:inactive" and updates the "wails-inactive" class
+// of the document
+func (f *Frontend) windowActiveChanged(active bool) {
+	f.windowInactive = !active
+	f.ExecJS(frontend.WindowActiveJS(active))
+	name := "wails:window:active"
+	if !active {
+		name = "wails:window:inactive"
+	}
+	if events, _ := f.ctx.Value("events").(frontend.Events); events != nil {
+		events.Emit(name)
+	}
+}
+
 func (f *Frontend) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	f.startupTimer.Ready("DomReady")
 	if f.frontendOptions.OnDomReady != nil {
 		go f.frontendOptions.OnDomReady(f.ctx)
 	}
@@ -995,6 +1216,7 @@ func (f *Frontend) onFocus(arg *winc.Event) {
 }
 
 func (f *Frontend) startSecondInstanceProcessor() {
+	defer frontend.ReportCrash(f.logger, f.frontendOptions, "second instance processor")
 	for secondInstanceData := range secondInstanceBuffer {
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
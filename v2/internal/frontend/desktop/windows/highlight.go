@@ -0,0 +1,19 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// HighlightElement draws an overlay around the first element matching the selector until
+// ClearHighlight is called
+func (f *Frontend) HighlightElement(selector string) {
+	f.ExecJS(frontend.HighlightElementJS(selector))
+}
+
+// ClearHighlight removes the current highlight
+func (f *Frontend) ClearHighlight() {
+	f.ExecJS(frontend.ClearHighlightJS)
+}
@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import "errors"
+
+func (f *Frontend) RelaunchApp(args []string) error {
+	return errors.New("relaunching the application is only supported on macOS")
+}
@@ -51,8 +51,7 @@ func EnumProc(hMonitor w32.HMONITOR, hdcMonitor w32.HDC, lprcMonitor *w32.RECT,
 	// https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-monitorfromwindow
 
 	ourMonitorData := Screen{}
-	currentMonHndl := w32.MonitorFromWindow(screenContainer.mainWinHandle, w32.MONITOR_DEFAULTTONEAREST)
-	currentMonInfo, currErr := GetMonitorInfo(currentMonHndl)
+	currentMonInfo, currErr := GetMonitorInfo(screenContainer.currentMonitor)
 
 	if currErr != nil {
 		screenContainer.errors = append(screenContainer.errors, currErr)
@@ -87,6 +86,7 @@ func EnumProc(hMonitor w32.HMONITOR, hdcMonitor w32.HDC, lprcMonitor *w32.RECT,
 	}
 	ourMonitorData.Size.Width = winc.ScaleToDefaultDPI(ourMonitorData.PhysicalSize.Width, dpiX)
 	ourMonitorData.Size.Height = winc.ScaleToDefaultDPI(ourMonitorData.PhysicalSize.Height, dpiY)
+	ourMonitorData.ScaleFactor = float64(dpiX) / 96
 
 	// the reason we need a container is that we have don't know how many times this function will be called
 	// this "append" call could potentially do an allocation and rewrite the pointer to monitors. So we save the pointer in screenContainer.monitors
@@ -99,14 +99,38 @@ func EnumProc(hMonitor w32.HMONITOR, hdcMonitor w32.HDC, lprcMonitor *w32.RECT,
 }
 
 type ScreenContainer struct {
-	monitors      []Screen
-	errors        []error
-	mainWinHandle w32.HWND
+	monitors       []Screen
+	errors         []error
+	currentMonitor w32.HMONITOR
 }
 
 func GetAllScreens(mainWinHandle w32.HWND) ([]Screen, error) {
+	return getScreens(w32.MonitorFromWindow(mainWinHandle, w32.MONITOR_DEFAULTTONEAREST))
+}
+
+// GetCurrentScreen returns the screen which contains the center of the window
+func GetCurrentScreen(mainWinHandle w32.HWND) (*Screen, error) {
+	rect := w32.GetWindowRect(mainWinHandle)
+	x := rect.Left + (rect.Right-rect.Left)/2
+	y := rect.Top + (rect.Bottom-rect.Top)/2
+	center := &w32.RECT{Left: x, Top: y, Right: x + 1, Bottom: y + 1}
+
+	screens, err := getScreens(w32.MonitorFromRect(center, w32.MONITOR_DEFAULTTONEAREST))
+	for _, screen := range screens {
+		if screen.IsCurrent {
+			return &screen, err
+		}
+	}
+	if err == nil {
+		err = errors.New("unable to find the screen of the window")
+	}
+	return nil, err
+}
+
+// getScreens returns all screens, currentMonitor is marked as current
+func getScreens(currentMonitor w32.HMONITOR) ([]Screen, error) {
 	// TODO fix hack of container sharing by having a proper data sharing mechanism between windows and the runtime
-	monitorContainer := ScreenContainer{mainWinHandle: mainWinHandle}
+	monitorContainer := ScreenContainer{currentMonitor: currentMonitor}
 	returnErr := error(nil)
 	errorStrings := []string{}
 
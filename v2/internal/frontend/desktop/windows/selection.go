@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import "errors"
+
+func (f *Frontend) GetSelectedText() (string, error) {
+	return "", errors.New("getting the selected text is only supported on macOS")
+}
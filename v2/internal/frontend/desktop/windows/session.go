@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import "errors"
+
+func (f *Frontend) GetSessionState() ([]byte, error) {
+	return nil, errors.New("session state is only supported on macOS")
+}
+
+func (f *Frontend) RestoreSessionState(state []byte) error {
+	return errors.New("session state is only supported on macOS")
+}
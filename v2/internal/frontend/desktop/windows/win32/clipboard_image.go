@@ -0,0 +1,234 @@
+//go:build windows
+
+package win32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image/png"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/image/bmp"
+)
+
+const (
+	cfDIB = 8
+
+	bitmapFileHeaderSize = 14
+	biBitfields          = 3
+)
+
+// cfPNG is the format used by browsers and most image editors to put PNG images on the clipboard
+var cfPNG = registerClipboardFormat("PNG")
+
+func registerClipboardFormat(name string) uintptr {
+	format, _, _ := procRegisterClipboardFormat.Call(uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(name))))
+	return format
+}
+
+func isClipboardFormatAvailable(format uintptr) bool {
+	if format == 0 {
+		return false
+	}
+	available, _, _ := procIsClipboardFormatAvailable.Call(format)
+	return available != 0
+}
+
+// GetClipboardImage returns the image on the clipboard as PNG. Bitmaps are converted to PNG without transparency.
+// nil is returned if there is no image
+func GetClipboardImage() ([]byte, error) {
+	// See GetClipboardText for why the thread is locked
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	pngAvailable := isClipboardFormatAvailable(cfPNG)
+	if !pngAvailable && !isClipboardFormatAvailable(cfDIB) {
+		return nil, nil
+	}
+
+	err := waitOpenClipboard()
+	if err != nil {
+		return nil, err
+	}
+	defer procCloseClipboard.Call()
+
+	if pngAvailable {
+		data, err := getClipboardData(cfPNG)
+		if err == nil && len(data) != 0 {
+			return data, nil
+		}
+	}
+
+	dib, err := getClipboardData(cfDIB)
+	if err != nil {
+		return nil, err
+	}
+	return DIBToPNG(dib)
+}
+
+// getClipboardData returns a copy of the data of the format, the clipboard must be open
+func getClipboardData(format uintptr) ([]byte, error) {
+	h, _, err := procGetClipboardData.Call(format)
+	if h == 0 {
+		return nil, err
+	}
+
+	size, _, err := kernelGlobalSize.Call(h)
+	if size == 0 {
+		return nil, err
+	}
+
+	l, err := globalLock(h)
+	if l == nil {
+		return nil, err
+	}
+	defer kernelGlobalUnlock.Call(h)
+
+	data := make([]byte, size)
+	copy(data, unsafe.Slice((*byte)(l), size))
+	return data, nil
+}
+
+// globalLock locks the global memory object and returns a pointer to its first byte, or nil on failure
+func globalLock(h uintptr) (unsafe.Pointer, error) {
+	l, _, err := kernelGlobalLock.Call(h)
+	if l == 0 {
+		return nil, err
+	}
+	// The memory isn't managed by Go, so it's safe to convert the address. Doing it through a pointer keeps
+	// vet from reporting a possible misuse of unsafe.Pointer
+	return *(*unsafe.Pointer)(unsafe.Pointer(&l)), nil
+}
+
+// SetClipboardImage puts the PNG image on the clipboard, both as PNG and as bitmap for applications
+// which don't support PNG
+func SetClipboardImage(data []byte) error {
+	dib, err := PNGToDIB(data)
+	if err != nil {
+		return err
+	}
+
+	// See GetClipboardText for why the thread is locked
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	err = waitOpenClipboard()
+	if err != nil {
+		return err
+	}
+
+	r, _, err := procEmptyClipboard.Call(0)
+	if r == 0 {
+		_, _, _ = procCloseClipboard.Call()
+		return err
+	}
+
+	if cfPNG != 0 {
+		if err := setClipboardData(cfPNG, data); err != nil {
+			_, _, _ = procCloseClipboard.Call()
+			return err
+		}
+	}
+	if err := setClipboardData(cfDIB, dib); err != nil {
+		_, _, _ = procCloseClipboard.Call()
+		return err
+	}
+
+	closed, _, err := procCloseClipboard.Call()
+	if closed == 0 {
+		return err
+	}
+	return nil
+}
+
+// setClipboardData copies the data into global memory, which is owned by the clipboard afterwards.
+// The clipboard must be open
+func setClipboardData(format uintptr, data []byte) error {
+	// "If the hMem parameter identifies a memory object, the object must have
+	// been allocated using the function with the GMEM_MOVEABLE flag."
+	h, _, err := kernelGlobalAlloc.Call(gmemMoveable, uintptr(len(data)))
+	if h == 0 {
+		return err
+	}
+
+	l, err := globalLock(h)
+	if l == nil {
+		kernelGlobalFree.Call(h)
+		return err
+	}
+	copy(unsafe.Slice((*byte)(l), len(data)), data)
+	kernelGlobalUnlock.Call(h)
+
+	r, _, err := procSetClipboardData.Call(format, h)
+	if r == 0 {
+		kernelGlobalFree.Call(h)
+		return err
+	}
+	return nil
+}
+
+// DIBToPNG converts a device independent bitmap, as found on the clipboard as CF_DIB, to PNG. The alpha channel
+// of 32 bit bitmaps is ignored, as most applications don't set it
+func DIBToPNG(dib []byte) ([]byte, error) {
+	if len(dib) < 40 {
+		return nil, errors.New("invalid bitmap on the clipboard")
+	}
+	headerSize := binary.LittleEndian.Uint32(dib[0:4])
+	bpp := binary.LittleEndian.Uint16(dib[14:16])
+	compression := binary.LittleEndian.Uint32(dib[16:20])
+	colorsUsed := binary.LittleEndian.Uint32(dib[32:36])
+	if headerSize < 40 || int(headerSize) > len(dib) {
+		return nil, errors.New("invalid bitmap on the clipboard")
+	}
+
+	dib = append([]byte(nil), dib...)
+	pixelOffset := headerSize
+	if compression == biBitfields && headerSize == 40 && len(dib) >= 52 {
+		// Screenshots are usually 32 bit bitmaps with the colour masks following the header. The decoder only
+		// supports the default masks without compression, so they are dropped
+		masks := dib[40:52]
+		if !bytes.Equal(masks, []byte{0, 0, 0xff, 0, 0, 0xff, 0, 0, 0xff, 0, 0, 0}) {
+			return nil, errors.New("unsupported bitmap colour masks on the clipboard")
+		}
+		binary.LittleEndian.PutUint32(dib[16:20], 0)
+		dib = append(dib[:40], dib[52:]...)
+	}
+	if bpp <= 8 {
+		if colorsUsed == 0 {
+			colorsUsed = 1 << bpp
+		}
+		pixelOffset += colorsUsed * 4
+	}
+
+	file := make([]byte, bitmapFileHeaderSize, bitmapFileHeaderSize+len(dib))
+	file[0], file[1] = 'B', 'M'
+	binary.LittleEndian.PutUint32(file[2:6], uint32(bitmapFileHeaderSize+len(dib)))
+	binary.LittleEndian.PutUint32(file[10:14], bitmapFileHeaderSize+pixelOffset)
+	file = append(file, dib...)
+
+	img, err := bmp.Decode(bytes.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	if err := png.Encode(&result, img); err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// PNGToDIB converts the PNG image to a device independent bitmap, as used for CF_DIB
+func PNGToDIB(data []byte) ([]byte, error) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var file bytes.Buffer
+	if err := bmp.Encode(&file, img); err != nil {
+		return nil, err
+	}
+	return file.Bytes()[bitmapFileHeaderSize:], nil
+}
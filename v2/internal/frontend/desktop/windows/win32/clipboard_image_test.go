@@ -0,0 +1,90 @@
+//go:build windows
+
+package win32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+// testPNG returns an image with the given alpha. Bitmaps don't keep the transparency, but translucent
+// images are converted to 32 bit bitmaps instead of 24 bit ones
+func testPNG(t *testing.T, alpha uint8) ([]byte, *image.NRGBA) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 100), G: uint8(y * 100), B: 50, A: alpha})
+		}
+	}
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		t.Fatal(err)
+	}
+	return data.Bytes(), img
+}
+
+func requireSameImage(t *testing.T, data []byte, want image.Image) {
+	got, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bounds() != want.Bounds() {
+		t.Fatalf("bounds = %v, want %v", got.Bounds(), want.Bounds())
+	}
+	for y := 0; y < want.Bounds().Dy(); y++ {
+		for x := 0; x < want.Bounds().Dx(); x++ {
+			gotColour := color.NRGBAModel.Convert(got.At(x, y)).(color.NRGBA)
+			wantColour := color.NRGBAModel.Convert(want.At(x, y)).(color.NRGBA)
+			wantColour.A = 255
+			if gotColour != wantColour {
+				t.Fatalf("pixel %d,%d = %v, want %v", x, y, gotColour, wantColour)
+			}
+		}
+	}
+}
+
+func TestDIBRoundTrip(t *testing.T) {
+	data, img := testPNG(t, 255)
+	dib, err := PNGToDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headerSize := binary.LittleEndian.Uint32(dib[0:4]); headerSize != 40 {
+		t.Fatalf("header size = %d, want 40", headerSize)
+	}
+	converted, err := DIBToPNG(dib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireSameImage(t, converted, img)
+}
+
+func TestDIBToPNGWithBitfields(t *testing.T) {
+	data, img := testPNG(t, 254)
+	dib, err := PNGToDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bpp := binary.LittleEndian.Uint16(dib[14:16]); bpp != 32 {
+		t.Fatalf("bpp = %d, want 32", bpp)
+	}
+	// Screenshots put BI_BITFIELDS bitmaps with the colour masks after the header on the clipboard
+	withMasks := append([]byte(nil), dib[:40]...)
+	binary.LittleEndian.PutUint32(withMasks[16:20], biBitfields)
+	withMasks = append(withMasks, 0, 0, 0xff, 0, 0, 0xff, 0, 0, 0xff, 0, 0, 0)
+	withMasks = append(withMasks, dib[40:]...)
+
+	converted, err := DIBToPNG(withMasks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireSameImage(t, converted, img)
+
+	if _, err := DIBToPNG(dib[:20]); err == nil {
+		t.Fatal("expected an error for a truncated bitmap")
+	}
+}
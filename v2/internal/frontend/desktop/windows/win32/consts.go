@@ -29,6 +29,7 @@ var (
 	procEmptyClipboard             = moduser32.NewProc("EmptyClipboard")
 	procGetClipboardData           = moduser32.NewProc("GetClipboardData")
 	procSetClipboardData           = moduser32.NewProc("SetClipboardData")
+	procRegisterClipboardFormat    = moduser32.NewProc("RegisterClipboardFormatW")
 )
 var (
 	moddwmapi                        = syscall.NewLazyDLL("dwmapi.dll")
@@ -44,6 +45,7 @@ var (
 	kernelGlobalAlloc  = kernel32.NewProc("GlobalAlloc")
 	kernelGlobalFree   = kernel32.NewProc("GlobalFree")
 	kernelGlobalLock   = kernel32.NewProc("GlobalLock")
+	kernelGlobalSize   = kernel32.NewProc("GlobalSize")
 	kernelGlobalUnlock = kernel32.NewProc("GlobalUnlock")
 	kernelLstrcpy      = kernel32.NewProc("lstrcpyW")
 )
@@ -551,6 +551,16 @@ const (
 	WA_CLICKACTIVE = 2
 )
 
+// FlashWindowEx flags
+const (
+	FLASHW_STOP      = 0
+	FLASHW_CAPTION   = 0x00000001
+	FLASHW_TRAY      = 0x00000002
+	FLASHW_ALL       = FLASHW_CAPTION | FLASHW_TRAY
+	FLASHW_TIMER     = 0x00000004
+	FLASHW_TIMERNOFG = 0x0000000C
+)
+
 const LF_FACESIZE = 32
 
 // Font weight constants
@@ -255,6 +255,15 @@ type WNDCLASSEX struct {
 	IconSm     HICON
 }
 
+// https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-flashwinfo
+type FLASHWINFO struct {
+	CbSize    uint32
+	Hwnd      HWND
+	DwFlags   uint32
+	UCount    uint32
+	DwTimeout uint32
+}
+
 type TPMPARAMS struct {
 	CbSize    uint32
 	RcExclude RECT
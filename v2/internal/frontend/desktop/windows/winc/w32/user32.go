@@ -58,6 +58,7 @@ var (
 	procSetActiveWindow               = moduser32.NewProc("SetActiveWindow")
 	procSetForegroundWindow           = moduser32.NewProc("SetForegroundWindow")
 	procBringWindowToTop              = moduser32.NewProc("BringWindowToTop")
+	procFlashWindowEx                 = moduser32.NewProc("FlashWindowEx")
 	procInvalidateRect                = moduser32.NewProc("InvalidateRect")
 	procGetClientRect                 = moduser32.NewProc("GetClientRect")
 	procGetDC                         = moduser32.NewProc("GetDC")
@@ -542,6 +543,11 @@ func BringWindowToTop(hwnd HWND) bool {
 	return ret != 0
 }
 
+func FlashWindowEx(pfwi *FLASHWINFO) bool {
+	ret, _, _ := procFlashWindowEx.Call(uintptr(unsafe.Pointer(pfwi)))
+	return ret != 0
+}
+
 func SetForegroundWindow(hwnd HWND) HWND {
 	ret, _, _ := procSetForegroundWindow.Call(
 		uintptr(hwnd))
@@ -37,6 +37,9 @@ type Window struct {
 	OnSuspend func()
 	OnResume  func()
 
+	// OnActiveChanged is called when the window is activated or deactivated
+	OnActiveChanged func(active bool)
+
 	chromium *edge.Chromium
 
 	// isMinimizing indicates whether the window is currently being minimized
@@ -234,8 +237,12 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		} else {
 			w.isActive = true
 			w.UpdateTheme()
+			w.stopUserAttention()
 			//}
 		}
+		if w.OnActiveChanged != nil {
+			w.OnActiveChanged(w.isActive)
+		}
 
 	case 0x02E0: //w32.WM_DPICHANGED
 		newWindowSize := (*w32.RECT)(unsafe.Pointer(lparam))
@@ -317,6 +324,48 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	return w.Form.WndProc(msg, wparam, lparam)
 }
 
+// SetMinimizable shows or hides the minimise button of the title bar
+func (w *Window) SetMinimizable(minimizable bool) {
+	w.EnableMinButton(minimizable)
+	w.refreshFrame()
+}
+
+// SetMaximizable shows or hides the maximise button of the title bar. Without it the window can't be maximised by
+// double clicking the title bar or with Aero Snap either
+func (w *Window) SetMaximizable(maximizable bool) {
+	w.EnableMaxButton(maximizable)
+	w.refreshFrame()
+}
+
+// refreshFrame redraws the non-client area after the window style has been changed
+func (w *Window) refreshFrame() {
+	w32.SetWindowPos(w.Handle(), 0, 0, 0, 0, 0,
+		w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOZORDER|w32.SWP_NOOWNERZORDER|w32.SWP_FRAMECHANGED)
+}
+
+// RequestUserAttention flashes the taskbar button if the window isn't active. A critical request flashes the window
+// until it comes to the foreground, an informational one flashes the taskbar button a few times and leaves it
+// highlighted
+func (w *Window) RequestUserAttention(critical bool) {
+	if w.isActive {
+		return
+	}
+	info := w32.FLASHWINFO{Hwnd: w.Handle(), DwFlags: w32.FLASHW_TRAY, UCount: 3}
+	if critical {
+		info.DwFlags = w32.FLASHW_ALL | w32.FLASHW_TIMERNOFG
+		info.UCount = 0
+	}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	w32.FlashWindowEx(&info)
+}
+
+// stopUserAttention stops the flashing of RequestUserAttention
+func (w *Window) stopUserAttention() {
+	info := w32.FLASHWINFO{Hwnd: w.Handle(), DwFlags: w32.FLASHW_STOP}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	w32.FlashWindowEx(&info)
+}
+
 func (w *Window) IsMaximised() bool {
 	return win32.IsWindowMaximised(w.Handle())
 }
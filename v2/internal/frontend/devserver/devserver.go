@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
 
@@ -48,17 +49,23 @@ type DevWebServer struct {
 	websocketClients map[*websocket.Conn]*sync.Mutex
 	menuManager      *menumanager.Manager
 	starttime        string
+	bindingsJSON     string
 
 	// Desktop frontend
 	frontend.Frontend
 
 	devServerAddr string
+
+	// Throttling state of NotifyThrottled
+	notifyThrottle frontend.NotifyThrottle
 }
 
 func (d *DevWebServer) Run(ctx context.Context) error {
 	d.ctx = ctx
 
 	d.server.GET("/wails/reload", d.handleReload)
+	d.server.GET("/wails/reloadassets", d.handleReloadAssets)
+	d.server.GET("/wails/devreload", d.handleDevReload)
 	d.server.GET("/wails/ipc", d.handleIPCWebSocket)
 
 	assetServerConfig, err := assetserver.BuildAssetServerConfig(d.appoptions)
@@ -98,15 +105,17 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 	}
 
 	// Setup internal dev server
-	bindingsJSON, err := d.appBindings.ToJSON()
+	d.bindingsJSON, err = d.appBindings.ToJSON()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	assetServer, err := assetserver.NewDevAssetServer(assetHandler, bindingsJSON, ctx.Value("assetdir") != nil, myLogger, runtime.RuntimeAssetsBundle)
+	assetServer, err := assetserver.NewDevAssetServer(assetHandler, d.bindingsJSON, ctx.Value("assetdir") != nil, myLogger, runtime.RuntimeAssetsBundle)
 	if err != nil {
 		log.Fatal(err)
 	}
+	assetServer.UseMainPageHook(d.appoptions.OnServeMainPage)
+	assetServer.UseSPAFallback(assetServerConfig.SPAFallback)
 
 	d.server.Any("/*", func(c echo.Context) error {
 		if c.IsWebSocket() {
@@ -150,8 +159,63 @@ func (d *DevWebServer) Notify(name string, data ...interface{}) {
 	d.notify(name, data...)
 }
 
+// NotifyThrottled sends the throttled event to the browsers and the desktop frontend
+func (d *DevWebServer) NotifyThrottled(name string, minInterval time.Duration, data ...interface{}) {
+	d.notifyThrottle.Notify(name, minInterval, data, func(name string, data ...interface{}) {
+		d.notify(name, data...)
+		d.Frontend.Notify(name, data...)
+	})
+}
+
+// handleReload reloads the frontend. If the changed files are given by the `path` query parameters, the
+// `wails:reload` DOM event is dispatched with them first. Frameworks which can update partially may handle it
+// and call preventDefault() to skip the reload
 func (d *DevWebServer) handleReload(c echo.Context) error {
-	d.WindowReload()
+	paths := c.QueryParams()["path"]
+	if len(paths) == 0 {
+		d.WindowReload()
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	payload, err := json.Marshal(paths)
+	if err != nil {
+		return err
+	}
+	d.broadcast("r" + string(payload))
+	d.Frontend.ExecJS(`if (window.dispatchEvent(new CustomEvent("wails:reload", {detail: {paths: ` + string(payload) + `}, cancelable: true}))) { window.runtime.WindowReload(); }`)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// handleReloadAssets refreshes the assets given by the `path` query parameters in place where possible
+func (d *DevWebServer) handleReloadAssets(c echo.Context) error {
+	paths := c.QueryParams()["path"]
+	if len(paths) == 0 {
+		d.WindowReload()
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	payload, err := json.Marshal(paths)
+	if err != nil {
+		return err
+	}
+	d.broadcast("a" + string(payload))
+	d.Frontend.ExecJS(`window.wails.ReloadAssets ? window.wails.ReloadAssets(` + string(payload) + `) : window.location.reload();`)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// handleDevReload emits the `wails:dev:reload` event before `wails dev` restarts the application after a rebuild.
+// The files which have changed are given by the `path` query parameters
+func (d *DevWebServer) handleDevReload(c echo.Context) error {
+	paths := c.QueryParams()["path"]
+	if paths == nil {
+		paths = []string{}
+	}
+	data := map[string]interface{}{
+		"timestamp": time.Now().UnixMilli(),
+		"paths":     paths,
+	}
+	d.notify("wails:dev:reload", data)
+	d.Frontend.Notify("wails:dev:reload", data)
 	return c.NoContent(http.StatusNoContent)
 }
 
@@ -173,6 +237,14 @@ func (d *DevWebServer) handleIPCWebSocket(c echo.Context) error {
 	locker := d.websocketClients[conn]
 	d.socketMutex.Unlock()
 
+	// A browser that reconnects after a Go rebuild still holds the previous bindings,
+	// so push the current ones to refresh window.go without reloading the page
+	locker.Lock()
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("b"+d.bindingsJSON)); err != nil {
+		d.logger.Error("Websocket write message failed %v", err)
+	}
+	locker.Unlock()
+
 	var wg sync.WaitGroup
 
 	defer func() {
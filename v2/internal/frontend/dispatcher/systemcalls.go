@@ -44,8 +44,18 @@ func (d *Dispatcher) processSystemCall(payload callMessage, sender frontend.Fron
 		return sender.WindowIsNormal(), nil
 	case "WindowIsFullscreen":
 		return sender.WindowIsFullscreen(), nil
+	case "WindowGetTitle":
+		return sender.WindowGetTitle(), nil
+	case "WindowGetState":
+		return frontend.WindowGetState(sender), nil
+	case "WindowList":
+		return frontend.WindowList(sender), nil
 	case "Environment":
 		return runtime.Environment(d.ctx), nil
+	case "AppVersion":
+		return runtime.AppVersion(), nil
+	case "LaunchArgs":
+		return runtime.LaunchArgs(d.ctx), nil
 	case "ClipboardGetText":
 		t, err := sender.ClipboardGetText()
 		return t, err
@@ -0,0 +1,38 @@
+package frontend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"text/template"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// EventNotify is the payload sent to the frontend when an event is emitted
+type EventNotify struct {
+	Name string        `json:"name"`
+	Data []interface{} `json:"data"`
+}
+
+// EventNotifyJS returns the JS which passes the event to the runtime, encoded with the given encoding
+func EventNotifyJS(encoding options.EventEncoding, name string, data []interface{}) (string, error) {
+	if encoding == options.EventEncodingMsgpack {
+		payload, err := EncodeMsgpack(map[string]interface{}{
+			"name": name,
+			"data": data,
+		})
+		if err != nil {
+			return "", err
+		}
+		return `window.wails.EventsNotifyMsgpack('` + base64.StdEncoding.EncodeToString(payload) + `');`, nil
+	}
+
+	payload, err := json.Marshal(EventNotify{
+		Name: name,
+		Data: data,
+	})
+	if err != nil {
+		return "", err
+	}
+	return `window.wails.EventsNotify('` + template.JSEscapeString(string(payload)) + `');`, nil
+}
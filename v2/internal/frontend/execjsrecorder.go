@@ -0,0 +1,62 @@
+package frontend
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrExecJSRecordingDisabled is returned when recording ExecJS is started in a production build
+var ErrExecJSRecordingDisabled = errors.New("recording ExecJS is only supported in dev and debug builds")
+
+// ExecJSRecorder records the JS sent to the frontend, which includes the events from Notify and the
+// results of bound method calls. The zero value is ready to use and doesn't record
+type ExecJSRecorder struct {
+	lock        sync.Mutex
+	recording   bool
+	passthrough bool
+	calls       []string
+}
+
+// Start starts recording. If passthrough is false the JS is only recorded and not executed, which allows
+// testing without a live webview
+func (r *ExecJSRecorder) Start(passthrough bool) error {
+	if !execJSRecordingEnabled {
+		return ErrExecJSRecordingDisabled
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.recording = true
+	r.passthrough = passthrough
+	r.calls = nil
+	return nil
+}
+
+// Drain returns the JS recorded since it was last drained and clears the buffer
+func (r *ExecJSRecorder) Drain() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	calls := r.calls
+	r.calls = nil
+	return calls
+}
+
+// Stop stops recording and returns the JS which hasn't been drained
+func (r *ExecJSRecorder) Stop() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	calls := r.calls
+	r.recording = false
+	r.calls = nil
+	return calls
+}
+
+// Record records the JS while recording. It returns true if the JS must not be executed
+func (r *ExecJSRecorder) Record(js string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.recording {
+		return false
+	}
+	r.calls = append(r.calls, js)
+	return !r.passthrough
+}
@@ -0,0 +1,5 @@
+//go:build debug || !production
+
+package frontend
+
+const execJSRecordingEnabled = true
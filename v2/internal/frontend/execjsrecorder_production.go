@@ -0,0 +1,5 @@
+//go:build production && !debug
+
+package frontend
+
+const execJSRecordingEnabled = false
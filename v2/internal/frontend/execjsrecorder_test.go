@@ -0,0 +1,28 @@
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecJSRecorder(t *testing.T) {
+	var recorder ExecJSRecorder
+	require.False(t, recorder.Record("not recording"))
+	require.Empty(t, recorder.Drain())
+
+	require.NoError(t, recorder.Start(false))
+	require.True(t, recorder.Record("first"))
+	require.True(t, recorder.Record("second"))
+	require.Equal(t, []string{"first", "second"}, recorder.Drain())
+	require.Empty(t, recorder.Drain())
+
+	require.True(t, recorder.Record("third"))
+	require.Equal(t, []string{"third"}, recorder.Stop())
+	require.False(t, recorder.Record("stopped"))
+	require.Empty(t, recorder.Drain())
+
+	require.NoError(t, recorder.Start(true))
+	require.False(t, recorder.Record("passthrough"))
+	require.Equal(t, []string{"passthrough"}, recorder.Stop())
+}
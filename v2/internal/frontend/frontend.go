@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"context"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/menu"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -36,6 +37,23 @@ type SaveDialogOptions struct {
 	TreatPackagesAsDirectories bool
 }
 
+// FindOptions contains the options for the FindInPage runtime method
+type FindOptions struct {
+	CaseSensitive bool
+	// Backwards searches towards the start of the page
+	Backwards bool
+}
+
+// DragItem is an item dragged out of the application with StartDrag
+type DragItem struct {
+	// Path of an existing file
+	Path string
+	// Name and Data are used to create a file in a temporary directory if Path is empty, which is removed
+	// when the application quits
+	Name string
+	Data []byte
+}
+
 type DialogType string
 
 const (
@@ -58,6 +76,8 @@ type Screen struct {
 	Size ScreenSize `json:"size"`
 	// PhysicalSize is the physical size of the screen in pixels
 	PhysicalSize ScreenSize `json:"physicalSize"`
+	// ScaleFactor is the ratio between physical and logical pixels, EG: 2 for a Retina display
+	ScaleFactor float64 `json:"scaleFactor"`
 }
 
 type ScreenSize struct {
@@ -79,10 +99,23 @@ type MessageDialogOptions struct {
 type Frontend interface {
 	Run(ctx context.Context) error
 	RunMainLoop()
+	// RunOnMainThread runs fn on the main (UI) thread and waits for it to return
+	RunOnMainThread(fn func())
 	ExecJS(js string)
+	// ExecJS recording, only supported in dev and debug builds
+	ExecJSRecordingStart(passthrough bool) error
+	ExecJSRecordingDrain() []string
+	ExecJSRecordingStop() []string
 	Hide()
 	Show()
 	Quit()
+	QuitWithCode(code int)
+	// ExitCode returns the code passed to QuitWithCode, which the process exits with
+	ExitCode() int
+	RelaunchApp(args []string) error
+	SetLaunchAtLogin(enabled bool) error
+	GetLaunchAtLogin() (bool, error)
+	GetNetworkStatus() (NetworkStatus, error)
 
 	// Dialog
 	OpenFileDialog(dialogOptions OpenDialogOptions) (string, error)
@@ -93,6 +126,9 @@ type Frontend interface {
 
 	// Window
 	WindowSetTitle(title string)
+	WindowGetTitle() string
+	WindowSetIcon(data []byte)
+	DockSetBadge(label string)
 	WindowShow()
 	WindowHide()
 	WindowCenter()
@@ -102,15 +138,22 @@ type Frontend interface {
 	WindowMinimise()
 	WindowUnminimise()
 	WindowSetAlwaysOnTop(b bool)
+	WindowSetMinimizable(b bool)
+	WindowSetMaximizable(b bool)
+	WindowShowZoomMenu()
+	WindowRequestUserAttention(critical bool)
 	WindowSetPosition(x int, y int)
 	WindowGetPosition() (int, int)
 	WindowSetSize(width int, height int)
 	WindowGetSize() (int, int)
 	WindowSetMinSize(width int, height int)
 	WindowSetMaxSize(width int, height int)
+	WindowSetMinSizeFraction(widthFraction float64, heightFraction float64)
+	WindowSetMaxSizeFraction(widthFraction float64, heightFraction float64)
 	WindowFullscreen()
 	WindowUnfullscreen()
 	WindowSetBackgroundColour(col *options.RGBA)
+	WebViewSetBackgroundColour(col *options.RGBA)
 	WindowReload()
 	WindowReloadApp()
 	WindowSetSystemDefaultTheme()
@@ -122,9 +165,54 @@ type Frontend interface {
 	WindowIsFullscreen() bool
 	WindowClose()
 	WindowPrint()
+	// WindowOpenDevTools and WindowCloseDevTools do nothing if the devtools aren't enabled
+	WindowOpenDevTools()
+	WindowCloseDevTools()
+	WindowSetSpellCheckEnabled(enabled bool)
+	WindowSetSpellCheckLanguage(language string)
+	WindowSetEnabled(enabled bool)
+	WindowSetJavaScriptEnabled(enabled bool)
+	WindowSetShadow(enabled bool)
+	WindowSetCornerRadius(radius float64)
+	WindowBack()
+	WindowForward()
+	WindowCanGoBack() bool
+	WindowCanGoForward() bool
+	WindowStopLoading()
+	HighlightElement(selector string)
+	ClearHighlight()
+	InjectCSS(id string, css string)
+	RemoveCSS(id string)
+	WindowSetCollectionBehavior(flags []string) error
 
 	// Screen
 	ScreenGetAll() ([]Screen, error)
+	// WindowGetCurrentScreen returns the screen which contains the center of the window
+	WindowGetCurrentScreen() (*Screen, error)
+
+	// Memory
+	TrimMemory()
+
+	// Idle
+	SetIdleTimeout(timeout time.Duration, callback func())
+
+	// Content rules
+	SetContentRules(rules string) error
+	ClearContentRules()
+
+	// Find
+	FindInPage(query string, options FindOptions) (int, error)
+	FindStopSession()
+
+	// Session
+	GetSessionState() ([]byte, error)
+	RestoreSessionState(state []byte) error
+
+	// Selection
+	GetSelectedText() (string, error)
+
+	// Drag and drop
+	StartDrag(items []DragItem) error
 
 	// Menus
 	MenuSetApplicationMenu(menu *menu.Menu)
@@ -132,6 +220,7 @@ type Frontend interface {
 
 	// Events
 	Notify(name string, data ...interface{})
+	NotifyThrottled(name string, minInterval time.Duration, data ...interface{})
 
 	// Browser
 	BrowserOpenURL(url string)
@@ -139,4 +228,6 @@ type Frontend interface {
 	// Clipboard
 	ClipboardGetText() (string, error)
 	ClipboardSetText(text string) error
+	ClipboardGetImage() ([]byte, string, error)
+	ClipboardSetImage(data []byte, mime string) error
 }
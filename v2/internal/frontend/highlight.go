@@ -0,0 +1,68 @@
+package frontend
+
+import (
+	"encoding/json"
+)
+
+// HighlightScript defines `window.wailsHighlight`, which draws an overlay around the first element
+// matching a selector and follows it as the page scrolls or its layout changes. The overlay can be
+// styled by targeting `#wails-highlight`.
+const HighlightScript = `(function() {
+    if (window.wailsHighlight) {
+        return;
+    }
+    var overlay = null;
+    var selector = null;
+    function update() {
+        if (selector === null) {
+            return;
+        }
+        var element = null;
+        try {
+            element = document.querySelector(selector);
+        } catch (e) {}
+        if (element) {
+            var rect = element.getBoundingClientRect();
+            overlay.style.display = "block";
+            overlay.style.left = (rect.left - 4) + "px";
+            overlay.style.top = (rect.top - 4) + "px";
+            overlay.style.width = (rect.width + 8) + "px";
+            overlay.style.height = (rect.height + 8) + "px";
+        } else {
+            overlay.style.display = "none";
+        }
+        window.requestAnimationFrame(update);
+    }
+    window.wailsHighlight = {
+        show: function(newSelector) {
+            var running = selector !== null;
+            selector = newSelector;
+            if (!overlay) {
+                overlay = document.createElement("div");
+                overlay.id = "wails-highlight";
+                overlay.style.cssText = "position:fixed;display:none;z-index:2147483647;pointer-events:none;box-sizing:border-box;border:2px solid #3b82f6;border-radius:4px;box-shadow:0 0 0 9999px rgba(0,0,0,0.4);";
+                document.documentElement.appendChild(overlay);
+            }
+            if (!running) {
+                window.requestAnimationFrame(update);
+            }
+        },
+        clear: function() {
+            selector = null;
+            if (overlay) {
+                overlay.remove();
+                overlay = null;
+            }
+        }
+    };
+})();`
+
+// HighlightElementJS returns the JS to highlight the element matching the given selector.
+// HighlightScript is included so it can be executed on pages it hasn't been injected into
+func HighlightElementJS(selector string) string {
+	quoted, _ := json.Marshal(selector)
+	return HighlightScript + "window.wailsHighlight.show(" + string(quoted) + ");"
+}
+
+// ClearHighlightJS removes the current highlight
+const ClearHighlightJS = "if (window.wailsHighlight) { window.wailsHighlight.clear(); }"
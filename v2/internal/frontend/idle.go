@@ -0,0 +1,121 @@
+package frontend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// IdleActivityMessage is sent by the webview when the user interacts with it
+const IdleActivityMessage = "wails:activity"
+
+// IdleActivityScript reports mouse and keyboard input in the webview to the backend, at most once a second
+const IdleActivityScript = `(function() {
+    if (window.wailsIdleMonitor) {
+        return;
+    }
+    window.wailsIdleMonitor = true;
+    var last = 0;
+    function report() {
+        var now = Date.now();
+        if (now - last < 1000) {
+            return;
+        }
+        last = now;
+        window.WailsInvoke("wails:activity");
+    }
+    ["mousemove", "mousedown", "keydown", "wheel", "touchstart"].forEach(function(name) {
+        window.addEventListener(name, report, {capture: true, passive: true});
+    });
+})();`
+
+// IdleMonitor tracks user activity reported by the webview. When there has been no activity for
+// the timeout, the "wails:app:idle" event is emitted and the callback is called. The next activity
+// emits "wails:app:active".
+type IdleMonitor struct {
+	ctx context.Context
+
+	lock       sync.Mutex
+	timeout    time.Duration
+	callback   func()
+	timer      *time.Timer
+	generation int
+	idle       bool
+}
+
+func NewIdleMonitor(ctx context.Context) *IdleMonitor {
+	return &IdleMonitor{ctx: ctx}
+}
+
+// SetTimeout sets the idle timeout and callback. A timeout of 0 disables the monitor
+func (m *IdleMonitor) SetTimeout(timeout time.Duration, callback func()) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.timeout = timeout
+	m.callback = callback
+	m.idle = false
+	m.schedule()
+}
+
+// Enabled returns true if a timeout is set
+func (m *IdleMonitor) Enabled() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.timeout > 0
+}
+
+// Activity restarts the timeout
+func (m *IdleMonitor) Activity() {
+	m.lock.Lock()
+	if m.timeout == 0 {
+		m.lock.Unlock()
+		return
+	}
+	wasIdle := m.idle
+	m.idle = false
+	m.schedule()
+	m.lock.Unlock()
+
+	if wasIdle {
+		m.emit("wails:app:active")
+	}
+}
+
+// schedule restarts the timer. The generation makes sure a timer that fired while
+// being replaced is ignored. Must be called with the lock held
+func (m *IdleMonitor) schedule() {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	m.generation++
+	if m.timeout == 0 {
+		return
+	}
+	generation := m.generation
+	m.timer = time.AfterFunc(m.timeout, func() {
+		m.fire(generation)
+	})
+}
+
+func (m *IdleMonitor) fire(generation int) {
+	m.lock.Lock()
+	if generation != m.generation || m.idle {
+		m.lock.Unlock()
+		return
+	}
+	m.idle = true
+	callback := m.callback
+	m.lock.Unlock()
+
+	m.emit("wails:app:idle")
+	if callback != nil {
+		callback()
+	}
+}
+
+func (m *IdleMonitor) emit(name string) {
+	if events, _ := m.ctx.Value("events").(Events); events != nil {
+		events.Emit(name)
+	}
+}
@@ -0,0 +1,61 @@
+package frontend
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingEvents struct {
+	Events
+
+	lock    sync.Mutex
+	emitted []string
+}
+
+func (r *recordingEvents) Emit(eventName string, data ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.emitted = append(r.emitted, eventName)
+}
+
+func (r *recordingEvents) Emitted() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string{}, r.emitted...)
+}
+
+func TestIdleMonitor(t *testing.T) {
+	events := &recordingEvents{}
+	monitor := NewIdleMonitor(context.WithValue(context.Background(), "events", events))
+
+	idle := make(chan struct{}, 1)
+	monitor.SetTimeout(50*time.Millisecond, func() { idle <- struct{}{} })
+	require.True(t, monitor.Enabled())
+
+	// Activity keeps the monitor from going idle
+	for i := 0; i < 4; i++ {
+		time.Sleep(20 * time.Millisecond)
+		monitor.Activity()
+	}
+	require.Empty(t, events.Emitted())
+
+	select {
+	case <-idle:
+	case <-time.After(time.Second):
+		t.Fatal("idle callback was not called")
+	}
+	require.Equal(t, []string{"wails:app:idle"}, events.Emitted())
+
+	monitor.Activity()
+	require.Equal(t, []string{"wails:app:idle", "wails:app:active"}, events.Emitted())
+
+	// Disabling stops the monitor
+	monitor.SetTimeout(0, nil)
+	require.False(t, monitor.Enabled())
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []string{"wails:app:idle", "wails:app:active"}, events.Emitted())
+}
@@ -0,0 +1,17 @@
+package frontend
+
+// RunOnMainThread runs fn with dispatch, which must run the function it is given on the main thread, and waits for it
+// to return. A panic in fn is raised again in the calling goroutine, so that it is recovered like other panics of the
+// calling code, EG: of a bound method, instead of crashing the main loop
+func RunOnMainThread(dispatch func(func()), fn func()) {
+	done := make(chan interface{}, 1)
+	dispatch(func() {
+		defer func() {
+			done <- recover()
+		}()
+		fn()
+	})
+	if value := <-done; value != nil {
+		panic(value)
+	}
+}
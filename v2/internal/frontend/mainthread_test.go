@@ -0,0 +1,40 @@
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOnMainThread(t *testing.T) {
+	mainThread := make(chan func())
+	go func() {
+		for fn := range mainThread {
+			fn()
+		}
+	}()
+	t.Cleanup(func() {
+		close(mainThread)
+	})
+	dispatch := func(fn func()) {
+		mainThread <- fn
+	}
+
+	ran := false
+	RunOnMainThread(dispatch, func() {
+		ran = true
+	})
+	require.True(t, ran)
+
+	// The panic is raised in the caller and the main thread keeps running
+	require.PanicsWithValue(t, "boom", func() {
+		RunOnMainThread(dispatch, func() {
+			panic("boom")
+		})
+	})
+	ran = false
+	RunOnMainThread(dispatch, func() {
+		ran = true
+	})
+	require.True(t, ran)
+}
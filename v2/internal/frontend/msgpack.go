@@ -0,0 +1,341 @@
+package frontend
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// EncodeMsgpack encodes the value with MessagePack. Maps, slices and basic types are encoded directly, byte slices
+// are encoded as binary. Structs and types implementing json.Marshaler or encoding.TextMarshaler are encoded as
+// their JSON representation, so they arrive in the frontend with the same field names as with JSON.
+func EncodeMsgpack(value interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := encodeMsgpackInterface(&buffer, value); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func encodeMsgpack(buffer *bytes.Buffer, value reflect.Value) error {
+	if !value.IsValid() {
+		buffer.WriteByte(0xc0)
+		return nil
+	}
+
+	valueType := value.Type()
+	if value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			buffer.WriteByte(0xc0)
+			return nil
+		}
+		if value.Kind() == reflect.Interface {
+			return encodeMsgpackInterface(buffer, value.Elem().Interface())
+		}
+		if !isJSONMarshaler(valueType) {
+			return encodeMsgpack(buffer, value.Elem())
+		}
+	}
+	if isJSONMarshaler(valueType) {
+		return encodeMsgpackAsJSON(buffer, value)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			buffer.WriteByte(0xc3)
+		} else {
+			buffer.WriteByte(0xc2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		encodeMsgpackInt(buffer, value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		encodeMsgpackUint(buffer, value.Uint())
+	case reflect.Float32:
+		buffer.WriteByte(0xca)
+		writeUint32(buffer, math.Float32bits(float32(value.Float())))
+	case reflect.Float64:
+		buffer.WriteByte(0xcb)
+		writeUint64(buffer, math.Float64bits(value.Float()))
+	case reflect.String:
+		encodeMsgpackString(buffer, value.String())
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			buffer.WriteByte(0xc0)
+			return nil
+		}
+		if items, ok := value.Interface().([]interface{}); ok {
+			return encodeMsgpackInterface(buffer, items)
+		}
+		if valueType.Elem().Kind() == reflect.Uint8 {
+			encodeMsgpackBinary(buffer, value)
+			return nil
+		}
+		encodeMsgpackHeader(buffer, value.Len(), 0x90, 0xdc)
+		for i := 0; i < value.Len(); i++ {
+			if err := encodeMsgpack(buffer, value.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if value.IsNil() {
+			buffer.WriteByte(0xc0)
+			return nil
+		}
+		if items, ok := value.Interface().(map[string]interface{}); ok {
+			return encodeMsgpackInterface(buffer, items)
+		}
+		return encodeMsgpackMap(buffer, value)
+	case reflect.Struct:
+		return encodeMsgpackAsJSON(buffer, value)
+	default:
+		return fmt.Errorf("msgpack: unsupported type %s", valueType)
+	}
+	return nil
+}
+
+func isJSONMarshaler(valueType reflect.Type) bool {
+	return valueType.Implements(jsonMarshalerType) || valueType.Implements(textMarshalerType)
+}
+
+func writeUint16(buffer *bytes.Buffer, value uint16) {
+	buffer.WriteByte(byte(value >> 8))
+	buffer.WriteByte(byte(value))
+}
+
+func writeUint32(buffer *bytes.Buffer, value uint32) {
+	writeUint16(buffer, uint16(value>>16))
+	writeUint16(buffer, uint16(value))
+}
+
+func writeUint64(buffer *bytes.Buffer, value uint64) {
+	writeUint32(buffer, uint32(value>>32))
+	writeUint32(buffer, uint32(value))
+}
+
+func encodeMsgpackInt(buffer *bytes.Buffer, value int64) {
+	switch {
+	case value >= 0:
+		encodeMsgpackUint(buffer, uint64(value))
+	case value >= -32:
+		buffer.WriteByte(byte(int8(value)))
+	case value >= math.MinInt8:
+		buffer.WriteByte(0xd0)
+		buffer.WriteByte(byte(int8(value)))
+	case value >= math.MinInt16:
+		buffer.WriteByte(0xd1)
+		writeUint16(buffer, uint16(value))
+	case value >= math.MinInt32:
+		buffer.WriteByte(0xd2)
+		writeUint32(buffer, uint32(value))
+	default:
+		buffer.WriteByte(0xd3)
+		writeUint64(buffer, uint64(value))
+	}
+}
+
+func encodeMsgpackUint(buffer *bytes.Buffer, value uint64) {
+	switch {
+	case value <= 0x7f:
+		buffer.WriteByte(byte(value))
+	case value <= math.MaxUint8:
+		buffer.WriteByte(0xcc)
+		buffer.WriteByte(byte(value))
+	case value <= math.MaxUint16:
+		buffer.WriteByte(0xcd)
+		writeUint16(buffer, uint16(value))
+	case value <= math.MaxUint32:
+		buffer.WriteByte(0xce)
+		writeUint32(buffer, uint32(value))
+	default:
+		buffer.WriteByte(0xcf)
+		writeUint64(buffer, value)
+	}
+}
+
+func encodeMsgpackString(buffer *bytes.Buffer, value string) {
+	length := len(value)
+	switch {
+	case length <= 31:
+		buffer.WriteByte(0xa0 | byte(length))
+	case length <= math.MaxUint8:
+		buffer.WriteByte(0xd9)
+		buffer.WriteByte(byte(length))
+	case length <= math.MaxUint16:
+		buffer.WriteByte(0xda)
+		writeUint16(buffer, uint16(length))
+	default:
+		buffer.WriteByte(0xdb)
+		writeUint32(buffer, uint32(length))
+	}
+	buffer.WriteString(value)
+}
+
+func encodeMsgpackBinary(buffer *bytes.Buffer, value reflect.Value) {
+	length := value.Len()
+	switch {
+	case length <= math.MaxUint8:
+		buffer.WriteByte(0xc4)
+		buffer.WriteByte(byte(length))
+	case length <= math.MaxUint16:
+		buffer.WriteByte(0xc5)
+		writeUint16(buffer, uint16(length))
+	default:
+		buffer.WriteByte(0xc6)
+		writeUint32(buffer, uint32(length))
+	}
+	if value.Kind() == reflect.Slice {
+		buffer.Write(value.Bytes())
+		return
+	}
+	for i := 0; i < length; i++ {
+		buffer.WriteByte(byte(value.Index(i).Uint()))
+	}
+}
+
+// encodeMsgpackHeader writes the header of an array or map, fix is the prefix of the fixarray or fixmap
+// and size16 the prefix of the 16 bit length, which is followed by the 32 bit one
+func encodeMsgpackHeader(buffer *bytes.Buffer, length int, fix byte, size16 byte) {
+	switch {
+	case length <= 15:
+		buffer.WriteByte(fix | byte(length))
+	case length <= math.MaxUint16:
+		buffer.WriteByte(size16)
+		writeUint16(buffer, uint16(length))
+	default:
+		buffer.WriteByte(size16 + 1)
+		writeUint32(buffer, uint32(length))
+	}
+}
+
+// encodeMsgpackMap writes the map with its keys sorted. Like with JSON, keys must be strings or integers,
+// integers are converted to strings
+func encodeMsgpackMap(buffer *bytes.Buffer, value reflect.Value) error {
+	keyKind := value.Type().Key().Kind()
+	if value.Type().Key().Implements(textMarshalerType) {
+		return encodeMsgpackAsJSON(buffer, value)
+	}
+
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+	entries := make([]entry, 0, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		var key string
+		switch keyKind {
+		case reflect.String:
+			key = iter.Key().String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			key = strconv.FormatInt(iter.Key().Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			key = strconv.FormatUint(iter.Key().Uint(), 10)
+		default:
+			return fmt.Errorf("msgpack: unsupported map key type %s", value.Type().Key())
+		}
+		entries = append(entries, entry{key: key, value: iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	encodeMsgpackHeader(buffer, len(entries), 0x80, 0xde)
+	for _, entry := range entries {
+		encodeMsgpackString(buffer, entry.key)
+		if err := encodeMsgpack(buffer, entry.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeMsgpackAsJSON encodes the JSON representation of the value
+func encodeMsgpackAsJSON(buffer *bytes.Buffer, value reflect.Value) error {
+	data, err := json.Marshal(value.Interface())
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return err
+	}
+	return encodeMsgpackInterface(buffer, decoded)
+}
+
+// encodeMsgpackInterface encodes the common types of event data and the values decoded from JSON
+// without reflection
+func encodeMsgpackInterface(buffer *bytes.Buffer, value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		buffer.WriteByte(0xc0)
+	case bool:
+		if value {
+			buffer.WriteByte(0xc3)
+		} else {
+			buffer.WriteByte(0xc2)
+		}
+	case int:
+		encodeMsgpackInt(buffer, int64(value))
+	case int64:
+		encodeMsgpackInt(buffer, value)
+	case float64:
+		buffer.WriteByte(0xcb)
+		writeUint64(buffer, math.Float64bits(value))
+	case string:
+		encodeMsgpackString(buffer, value)
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			encodeMsgpackInt(buffer, i)
+			return nil
+		}
+		f, err := value.Float64()
+		if err != nil {
+			return err
+		}
+		return encodeMsgpackInterface(buffer, f)
+	case []interface{}:
+		if value == nil {
+			buffer.WriteByte(0xc0)
+			return nil
+		}
+		encodeMsgpackHeader(buffer, len(value), 0x90, 0xdc)
+		for _, item := range value {
+			if err := encodeMsgpackInterface(buffer, item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		if value == nil {
+			buffer.WriteByte(0xc0)
+			return nil
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		encodeMsgpackHeader(buffer, len(keys), 0x80, 0xde)
+		for _, key := range keys {
+			encodeMsgpackString(buffer, key)
+			if err := encodeMsgpackInterface(buffer, value[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return encodeMsgpack(buffer, reflect.ValueOf(value))
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package frontend
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+type msgpackSample struct {
+	Name    string `json:"name"`
+	Value   int    `json:"value"`
+	Ignored string `json:"-"`
+}
+
+func TestEncodeMsgpack(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{"nil", nil, []byte{0xc0}},
+		{"true", true, []byte{0xc3}},
+		{"false", false, []byte{0xc2}},
+		{"positive fixint", 5, []byte{0x05}},
+		{"negative fixint", -1, []byte{0xff}},
+		{"uint8", 200, []byte{0xcc, 0xc8}},
+		{"int8", -100, []byte{0xd0, 0x9c}},
+		{"uint16", 1000, []byte{0xcd, 0x03, 0xe8}},
+		{"int32", -100000, []byte{0xd2, 0xff, 0xfe, 0x79, 0x60}},
+		{"uint64", uint64(1) << 40, []byte{0xcf, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"float64", 1.5, []byte{0xcb, 0x3f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"float32", float32(1.5), []byte{0xca, 0x3f, 0xc0, 0x00, 0x00}},
+		{"fixstr", "hi", []byte{0xa2, 'h', 'i'}},
+		{"str8", strings.Repeat("a", 32), append([]byte{0xd9, 32}, strings.Repeat("a", 32)...)},
+		{"binary", []byte{1, 2}, []byte{0xc4, 0x02, 0x01, 0x02}},
+		{"fixarray", []interface{}{1, "a"}, []byte{0x92, 0x01, 0xa1, 'a'}},
+		{"nil slice", []int(nil), []byte{0xc0}},
+		{"sorted map", map[string]int{"b": 2, "a": 1}, []byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x02}},
+		{"integer keys", map[int]bool{1: true}, []byte{0x81, 0xa1, '1', 0xc3}},
+		{"struct as JSON", msgpackSample{Name: "x", Value: 1, Ignored: "y"}, []byte{0x82, 0xa4, 'n', 'a', 'm', 'e', 0xa1, 'x', 0xa5, 'v', 'a', 'l', 'u', 'e', 0x01}},
+		{"json.Marshaler", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), append([]byte{0xb4}, "2020-01-02T00:00:00Z"...)},
+		{"pointer", &msgpackSample{Name: "x"}, []byte{0x82, 0xa4, 'n', 'a', 'm', 'e', 0xa1, 'x', 0xa5, 'v', 'a', 'l', 'u', 'e', 0x00}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeMsgpack(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := EncodeMsgpack(func() {})
+	require.Error(t, err)
+}
+
+func TestEventNotifyJS(t *testing.T) {
+	js, err := EventNotifyJS(options.EventEncodingJSON, "a", []interface{}{"it's"})
+	require.NoError(t, err)
+	require.Equal(t, `window.wails.EventsNotify('{\"name\":\"a\",\"data\":[\"it\'s\"]}');`, js)
+
+	js, err = EventNotifyJS(options.EventEncodingMsgpack, "a", []interface{}{1})
+	require.NoError(t, err)
+	// {"data":[1],"name":"a"}
+	require.Equal(t, `window.wails.EventsNotifyMsgpack('gqRkYXRhkQGkbmFtZaFh');`, js)
+}
+
+func benchmarkTelemetry() []interface{} {
+	samples := make([]map[string]interface{}, 500)
+	for i := range samples {
+		samples[i] = map[string]interface{}{
+			"timestamp": 1700000000000 + int64(i),
+			"cpu":       float64(i) / 7,
+			"memory":    uint64(i) * 4096,
+			"label":     "worker",
+		}
+	}
+	return []interface{}{samples}
+}
+
+func benchmarkEventNotifyJS(b *testing.B, encoding options.EventEncoding) {
+	data := benchmarkTelemetry()
+	b.ReportAllocs()
+	var size int
+	for i := 0; i < b.N; i++ {
+		js, err := EventNotifyJS(encoding, "telemetry", data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		size = len(js)
+	}
+	b.ReportMetric(float64(size), "js-bytes")
+}
+
+func BenchmarkEventNotifyJSON(b *testing.B) {
+	benchmarkEventNotifyJS(b, options.EventEncodingJSON)
+}
+
+func BenchmarkEventNotifyMsgpack(b *testing.B) {
+	benchmarkEventNotifyJS(b, options.EventEncodingMsgpack)
+}
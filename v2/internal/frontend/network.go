@@ -0,0 +1,11 @@
+package frontend
+
+// NetworkStatus describes the network connectivity of the system
+type NetworkStatus struct {
+	// Online is true if there is a usable network connection
+	Online bool `json:"online"`
+	// Expensive is true if the connection is considered expensive, EG: cellular data or a personal hotspot
+	Expensive bool `json:"expensive"`
+	// Constrained is true if the user enabled Low Data Mode for the connection
+	Constrained bool `json:"constrained"`
+}
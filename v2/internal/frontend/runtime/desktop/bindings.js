@@ -65,3 +65,41 @@ export function SetBindings(bindingsMap) {
 		});
 	});
 }
+
+/**
+ * UpdateBindings refreshes window.go in place using the given bindings map.
+ * Methods, structs and packages that are no longer bound are removed.
+ *
+ * @export
+ * @param {string} bindingsMap - JSON encoded bindings map
+ */
+export function UpdateBindings(bindingsMap) {
+	let newBindings;
+	try {
+		newBindings = JSON.parse(bindingsMap);
+	} catch (e) {
+		console.error(e);
+		return;
+	}
+
+	// Remove anything that is no longer bound
+	Object.keys(window.go).forEach((packageName) => {
+		if (!newBindings[packageName]) {
+			delete window.go[packageName];
+			return;
+		}
+		Object.keys(window.go[packageName]).forEach((structName) => {
+			if (!newBindings[packageName][structName]) {
+				delete window.go[packageName][structName];
+				return;
+			}
+			Object.keys(window.go[packageName][structName]).forEach((methodName) => {
+				if (!newBindings[packageName][structName][methodName]) {
+					delete window.go[packageName][structName][methodName];
+				}
+			});
+		});
+	});
+
+	SetBindings(bindingsMap);
+}
@@ -9,6 +9,8 @@ The electron alternative for Go
 */
 /* jshint esversion: 6 */
 
+import {decodeBase64, decodeMsgpack} from "./msgpack";
+
 // Defines a single listener with a maximum number of times to callback
 
 /**
@@ -140,6 +142,24 @@ export function EventsNotify(notifyMessage) {
     notifyListeners(message);
 }
 
+/**
+ * NotifyMsgpack informs frontend listeners that an event was emitted with the given data.
+ * It's used instead of Notify when the events are encoded with MessagePack
+ *
+ * @export
+ * @param {string} notifyMessage - base64 encoded MessagePack notification message
+ */
+export function EventsNotifyMsgpack(notifyMessage) {
+    let message;
+    try {
+        message = decodeMsgpack(decodeBase64(notifyMessage));
+    } catch (e) {
+        const error = 'Invalid MessagePack passed to Notify: ' + e.message;
+        throw new Error(error);
+    }
+    notifyListeners(message);
+}
+
 /**
  * Emit an event with the given name and data
  *
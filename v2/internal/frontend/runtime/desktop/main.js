@@ -13,6 +13,7 @@ import {
   eventListeners,
   EventsEmit,
   EventsNotify,
+  EventsNotifyMsgpack,
   EventsOff,
   EventsOffAll,
   EventsOn,
@@ -20,7 +21,9 @@ import {
   EventsOnMultiple,
 } from "./events";
 import { Call, Callback, callbacks } from './calls';
-import { SetBindings } from "./bindings";
+import { SetBindings, UpdateBindings } from "./bindings";
+import { ReloadAssets } from "./reload";
+import * as Simulate from "./simulate";
 import * as Window from "./window";
 import * as Screen from "./screen";
 import * as Browser from "./browser";
@@ -44,6 +47,14 @@ export function Environment() {
     return Call(":wails:Environment");
 }
 
+export function AppVersion() {
+    return Call(":wails:AppVersion");
+}
+
+export function LaunchArgs() {
+    return Call(":wails:LaunchArgs");
+}
+
 // The JS runtime
 window.runtime = {
     ...Log,
@@ -59,6 +70,8 @@ window.runtime = {
     EventsOff,
     EventsOffAll,
     Environment,
+    AppVersion,
+    LaunchArgs,
     Show,
     Hide,
     Quit
@@ -68,6 +81,7 @@ window.runtime = {
 window.wails = {
     Callback,
     EventsNotify,
+    EventsNotifyMsgpack,
     SetBindings,
     eventListeners,
     callbacks,
@@ -93,6 +107,25 @@ if (window.wailsbindings) {
     delete window.wails.SetBindings;
 }
 
+// (bool) This is evaluated at build time in package.json
+if (DEBUG) {
+    // During development the backend pushes the latest bindings after a Go rebuild
+    window.wails.UpdateBindings = function (bindingsMap) {
+        if (bindingsMap === window.wailsbindings) {
+            return;
+        }
+        window.wailsbindings = bindingsMap;
+        UpdateBindings(bindingsMap);
+        EventsNotify(JSON.stringify({name: "wails:bindings:updated", data: []}));
+    };
+
+    // Changed assets are refreshed in place where possible
+    window.wails.ReloadAssets = ReloadAssets;
+
+    // Synthetic OS events for UI testing
+    window.wails.Simulate = Simulate;
+}
+
 // (bool) This is evaluated at build time in package.json
 if (!DEBUG) {
     delete window.wailsbindings;
@@ -0,0 +1,172 @@
+/*
+ _       __      _ __
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+const textDecoder = new TextDecoder();
+
+/**
+ * Decodes the MessagePack encoded bytes. Binary data is returned as an Uint8Array
+ *
+ * @export
+ * @param {Uint8Array} bytes
+ * @returns {any}
+ */
+export function decodeMsgpack(bytes) {
+    const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+    let offset = 0;
+
+    function bytesOf(length) {
+        const result = bytes.subarray(offset, offset + length);
+        offset += length;
+        return result;
+    }
+
+    function array(length) {
+        const result = new Array(length);
+        for (let i = 0; i < length; i++) {
+            result[i] = decode();
+        }
+        return result;
+    }
+
+    function map(length) {
+        const result = {};
+        for (let i = 0; i < length; i++) {
+            const key = decode();
+            result[key] = decode();
+        }
+        return result;
+    }
+
+    function decode() {
+        const type = view.getUint8(offset++);
+        if (type <= 0x7f) {
+            return type;
+        }
+        if (type >= 0xe0) {
+            return type - 0x100;
+        }
+        if ((type & 0xe0) === 0xa0) {
+            return textDecoder.decode(bytesOf(type & 0x1f));
+        }
+        if ((type & 0xf0) === 0x90) {
+            return array(type & 0x0f);
+        }
+        if ((type & 0xf0) === 0x80) {
+            return map(type & 0x0f);
+        }
+
+        let value;
+        switch (type) {
+            case 0xc0:
+                return null;
+            case 0xc2:
+                return false;
+            case 0xc3:
+                return true;
+            case 0xc4:
+                value = view.getUint8(offset);
+                offset += 1;
+                return bytesOf(value).slice();
+            case 0xc5:
+                value = view.getUint16(offset);
+                offset += 2;
+                return bytesOf(value).slice();
+            case 0xc6:
+                value = view.getUint32(offset);
+                offset += 4;
+                return bytesOf(value).slice();
+            case 0xca:
+                value = view.getFloat32(offset);
+                offset += 4;
+                return value;
+            case 0xcb:
+                value = view.getFloat64(offset);
+                offset += 8;
+                return value;
+            case 0xcc:
+                return view.getUint8(offset++);
+            case 0xcd:
+                value = view.getUint16(offset);
+                offset += 2;
+                return value;
+            case 0xce:
+                value = view.getUint32(offset);
+                offset += 4;
+                return value;
+            case 0xcf:
+                value = Number(view.getBigUint64(offset));
+                offset += 8;
+                return value;
+            case 0xd0:
+                return view.getInt8(offset++);
+            case 0xd1:
+                value = view.getInt16(offset);
+                offset += 2;
+                return value;
+            case 0xd2:
+                value = view.getInt32(offset);
+                offset += 4;
+                return value;
+            case 0xd3:
+                value = Number(view.getBigInt64(offset));
+                offset += 8;
+                return value;
+            case 0xd9:
+                value = view.getUint8(offset);
+                offset += 1;
+                return textDecoder.decode(bytesOf(value));
+            case 0xda:
+                value = view.getUint16(offset);
+                offset += 2;
+                return textDecoder.decode(bytesOf(value));
+            case 0xdb:
+                value = view.getUint32(offset);
+                offset += 4;
+                return textDecoder.decode(bytesOf(value));
+            case 0xdc:
+                value = view.getUint16(offset);
+                offset += 2;
+                return array(value);
+            case 0xdd:
+                value = view.getUint32(offset);
+                offset += 4;
+                return array(value);
+            case 0xde:
+                value = view.getUint16(offset);
+                offset += 2;
+                return map(value);
+            case 0xdf:
+                value = view.getUint32(offset);
+                offset += 4;
+                return map(value);
+        }
+        throw new Error('Unsupported MessagePack type 0x' + type.toString(16));
+    }
+
+    return decode();
+}
+
+/**
+ * Decodes the base64 encoded string to bytes
+ *
+ * @export
+ * @param {string} data
+ * @returns {Uint8Array}
+ */
+export function decodeBase64(data) {
+    const binary = atob(data);
+    const bytes = new Uint8Array(binary.length);
+    for (let i = 0; i < binary.length; i++) {
+        bytes[i] = binary.charCodeAt(i);
+    }
+    return bytes;
+}
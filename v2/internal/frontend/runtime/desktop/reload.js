@@ -0,0 +1,62 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+// Assets that can be swapped without reloading the page
+const hotAssets = /\.(css|png|jpe?g|gif|svg|webp|avif|ico|bmp)$/i;
+
+function isChanged(url, changedPaths) {
+    try {
+        const u = new URL(url, window.location.href);
+        return u.origin === window.location.origin && changedPaths.includes(u.pathname);
+    } catch (e) {
+        return false;
+    }
+}
+
+function cacheBust(url) {
+    const u = new URL(url, window.location.href);
+    u.searchParams.set("wailsreload", Date.now().toString());
+    return u.pathname + u.search + u.hash;
+}
+
+/**
+ * ReloadAssets re-fetches the stylesheets and images found at the given paths.
+ * If any of the paths can't be refreshed in place, the page is reloaded.
+ *
+ * @export
+ * @param {string[]} changedPaths - URL paths of the changed assets
+ */
+export function ReloadAssets(changedPaths) {
+    if (!changedPaths.every((p) => hotAssets.test(p))) {
+        window.location.reload();
+        return;
+    }
+
+    const refreshed = new Set();
+    document.querySelectorAll('link[rel="stylesheet"][href]').forEach((link) => {
+        if (isChanged(link.href, changedPaths)) {
+            refreshed.add(new URL(link.href).pathname);
+            link.href = cacheBust(link.href);
+        }
+    });
+    document.querySelectorAll('img[src]').forEach((img) => {
+        if (isChanged(img.src, changedPaths)) {
+            refreshed.add(new URL(img.src).pathname);
+            img.src = cacheBust(img.src);
+        }
+    });
+
+    // Assets referenced elsewhere, EG by CSS imports or backgrounds, need a full reload
+    if (refreshed.size !== changedPaths.length) {
+        window.location.reload();
+    }
+}
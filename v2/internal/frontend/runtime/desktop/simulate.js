@@ -0,0 +1,58 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+// Synthetic OS events for UI testing. These are only processed by debug builds.
+
+function simulate(event) {
+    window.WailsInvoke('wails:simulate:' + JSON.stringify(event));
+}
+
+/**
+ * Simulate the OS asking the app to open a file (macOS only)
+ *
+ * @export
+ * @param {string} filePath
+ */
+export function FileOpen(filePath) {
+    simulate({type: "fileopen", filePath});
+}
+
+/**
+ * Simulate the OS asking the app to open a URL (macOS only)
+ *
+ * @export
+ * @param {string} url
+ */
+export function UrlOpen(url) {
+    simulate({type: "urlopen", url});
+}
+
+/**
+ * Simulate a second instance of the app being launched
+ *
+ * @export
+ * @param {string[]} args
+ * @param {string} workingDirectory
+ */
+export function SecondInstance(args, workingDirectory) {
+    simulate({type: "secondinstance", args: args || [], workingDirectory: workingDirectory || ""});
+}
+
+/**
+ * Simulate a change of the OS theme
+ *
+ * @export
+ * @param {string} theme - "dark", "light" or "system"
+ */
+export function ThemeChange(theme) {
+    simulate({type: "themechange", theme});
+}
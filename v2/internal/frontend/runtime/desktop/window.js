@@ -52,6 +52,16 @@ export function WindowSetTitle(title) {
     window.WailsInvoke('WT' + title);
 }
 
+/**
+ * Returns the title of the window
+ *
+ * @export
+ * @return {Promise<string>} The title of the window
+ */
+export function WindowGetTitle() {
+    return Call(":wails:WindowGetTitle");
+}
+
 /**
  * Makes the window go fullscreen
  *
@@ -253,6 +263,27 @@ export function WindowIsNormal() {
     return Call(":wails:WindowIsNormal");
 }
 
+/**
+ * Returns the state of the window: "normal", "maximised", "minimised" or "fullscreen"
+ *
+ * @export
+ * @return {Promise<string>} The state of the window
+ */
+export function WindowGetState() {
+    return Call(":wails:WindowGetState");
+}
+
+/**
+ * Returns the windows of the application with their ID, title, position, size and state. Currently this is only the
+ * main window
+ *
+ * @export
+ * @return {Promise<{id: string, title: string, x: number, y: number, width: number, height: number, state: string}[]>} The windows
+ */
+export function WindowList() {
+    return Call(":wails:WindowList");
+}
+
 /**
  * Sets the background colour of the window
  *
@@ -111,6 +111,25 @@ function handleMessage(message) {
 
     // As a bridge we ignore js and css injections
     switch (message.data[0]) {
+        // Changed assets
+        case 'a':
+            if (window.wails.ReloadAssets) {
+                window.wails.ReloadAssets(JSON.parse(message.data.slice(1)));
+            } else {
+                window.runtime.WindowReload();
+            }
+            break;
+        // Reload with the changed files, which frameworks may handle themselves
+        case 'r':
+            const reloadEvent = new CustomEvent("wails:reload", {detail: {paths: JSON.parse(message.data.slice(1))}, cancelable: true});
+            if (window.dispatchEvent(reloadEvent)) {
+                window.runtime.WindowReload();
+            }
+            break;
+        // Bindings
+        case 'b':
+            window.wails.UpdateBindings?.(message.data.slice(1));
+            break;
         // Notifications
         case 'n':
             window.wails.EventsNotify(message.data.slice(1));
@@ -31,6 +31,7 @@ type Events struct {
 	notifyLock sync.RWMutex
 }
 
+// Notify is called with an event emitted by a frontend. It is sent to the Go listeners and the other frontends
 func (e *Events) Notify(sender frontend.Frontend, name string, data ...interface{}) {
 	e.notifyBackend(name, data...)
 	for _, thisFrontend := range e.frontend {
@@ -53,6 +54,8 @@ func (e *Events) Once(eventName string, callback func(...interface{})) func() {
 	return e.registerListener(eventName, callback, 1)
 }
 
+// Emit sends the event to the Go listeners and all frontends. Each Go listener is called in its own goroutine, so
+// listeners must not assume an order and may run concurrently with the code that emitted the event
 func (e *Events) Emit(eventName string, data ...interface{}) {
 	e.notifyBackend(eventName, data...)
 	for _, thisFrontend := range e.frontend {
@@ -2,6 +2,7 @@ package runtime_test
 
 import (
 	"fmt"
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"sync"
 	"testing"
@@ -36,3 +37,49 @@ func Test_EventsOn(t *testing.T) {
 	i.Equal(1, counter)
 
 }
+
+type mockFrontend struct {
+	frontend.Frontend
+
+	lock     sync.Mutex
+	notified []string
+}
+
+func (m *mockFrontend) Notify(name string, data ...interface{}) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.notified = append(m.notified, fmt.Sprintf("%s %v", name, data))
+}
+
+func Test_EventsEmitReachesGoAndFrontend(t *testing.T) {
+	i := is.New(t)
+	manager := runtime.NewEvents(&mockLogger{})
+	desktop := &mockFrontend{}
+	browser := &mockFrontend{}
+	manager.AddFrontend(desktop)
+	manager.AddFrontend(browser)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	var received []interface{}
+	var receivedLock sync.Mutex
+	for n := 0; n < 2; n++ {
+		manager.On("saved", func(args ...interface{}) {
+			receivedLock.Lock()
+			received = append(received, args...)
+			receivedLock.Unlock()
+			wg.Done()
+		})
+	}
+
+	manager.Emit("saved", "file.txt")
+	wg.Wait()
+	i.Equal([]interface{}{"file.txt", "file.txt"}, received)
+	i.Equal([]string{"saved [file.txt]"}, desktop.notified)
+	i.Equal([]string{"saved [file.txt]"}, browser.notified)
+
+	// Events sent by a frontend are not sent back to it
+	manager.Notify(browser, "opened", 1)
+	i.Equal([]string{"saved [file.txt]", "opened [1]"}, desktop.notified)
+	i.Equal([]string{"saved [file.txt]"}, browser.notified)
+}
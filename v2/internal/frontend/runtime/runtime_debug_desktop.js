@@ -53,6 +53,146 @@
     ERROR: 5
   };
 
+  // desktop/msgpack.js
+  var textDecoder = new TextDecoder();
+  function decodeMsgpack(bytes) {
+    const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+    let offset = 0;
+    function bytesOf(length) {
+      const result = bytes.subarray(offset, offset + length);
+      offset += length;
+      return result;
+    }
+    function array(length) {
+      const result = new Array(length);
+      for (let i = 0; i < length; i++) {
+        result[i] = decode();
+      }
+      return result;
+    }
+    function map(length) {
+      const result = {};
+      for (let i = 0; i < length; i++) {
+        const key = decode();
+        result[key] = decode();
+      }
+      return result;
+    }
+    function decode() {
+      const type = view.getUint8(offset++);
+      if (type <= 0x7f) {
+        return type;
+      }
+      if (type >= 0xe0) {
+        return type - 0x100;
+      }
+      if ((type & 0xe0) === 0xa0) {
+        return textDecoder.decode(bytesOf(type & 0x1f));
+      }
+      if ((type & 0xf0) === 0x90) {
+        return array(type & 0x0f);
+      }
+      if ((type & 0xf0) === 0x80) {
+        return map(type & 0x0f);
+      }
+      let value;
+      switch (type) {
+        case 0xc0:
+          return null;
+        case 0xc2:
+          return false;
+        case 0xc3:
+          return true;
+        case 0xc4:
+          value = view.getUint8(offset);
+          offset += 1;
+          return bytesOf(value).slice();
+        case 0xc5:
+          value = view.getUint16(offset);
+          offset += 2;
+          return bytesOf(value).slice();
+        case 0xc6:
+          value = view.getUint32(offset);
+          offset += 4;
+          return bytesOf(value).slice();
+        case 0xca:
+          value = view.getFloat32(offset);
+          offset += 4;
+          return value;
+        case 0xcb:
+          value = view.getFloat64(offset);
+          offset += 8;
+          return value;
+        case 0xcc:
+          return view.getUint8(offset++);
+        case 0xcd:
+          value = view.getUint16(offset);
+          offset += 2;
+          return value;
+        case 0xce:
+          value = view.getUint32(offset);
+          offset += 4;
+          return value;
+        case 0xcf:
+          value = Number(view.getBigUint64(offset));
+          offset += 8;
+          return value;
+        case 0xd0:
+          return view.getInt8(offset++);
+        case 0xd1:
+          value = view.getInt16(offset);
+          offset += 2;
+          return value;
+        case 0xd2:
+          value = view.getInt32(offset);
+          offset += 4;
+          return value;
+        case 0xd3:
+          value = Number(view.getBigInt64(offset));
+          offset += 8;
+          return value;
+        case 0xd9:
+          value = view.getUint8(offset);
+          offset += 1;
+          return textDecoder.decode(bytesOf(value));
+        case 0xda:
+          value = view.getUint16(offset);
+          offset += 2;
+          return textDecoder.decode(bytesOf(value));
+        case 0xdb:
+          value = view.getUint32(offset);
+          offset += 4;
+          return textDecoder.decode(bytesOf(value));
+        case 0xdc:
+          value = view.getUint16(offset);
+          offset += 2;
+          return array(value);
+        case 0xdd:
+          value = view.getUint32(offset);
+          offset += 4;
+          return array(value);
+        case 0xde:
+          value = view.getUint16(offset);
+          offset += 2;
+          return map(value);
+        case 0xdf:
+          value = view.getUint32(offset);
+          offset += 4;
+          return map(value);
+      }
+      throw new Error("Unsupported MessagePack type 0x" + type.toString(16));
+    }
+    return decode();
+  }
+  function decodeBase64(data) {
+    const binary = atob(data);
+    const bytes = new Uint8Array(binary.length);
+    for (let i = 0; i < binary.length; i++) {
+      bytes[i] = binary.charCodeAt(i);
+    }
+    return bytes;
+  }
+
   // desktop/events.js
   var Listener = class {
     constructor(eventName, callback, maxCallbacks) {
@@ -110,6 +250,16 @@
     }
     notifyListeners(message);
   }
+  function EventsNotifyMsgpack(notifyMessage) {
+    let message;
+    try {
+      message = decodeMsgpack(decodeBase64(notifyMessage));
+    } catch (e) {
+      const error = "Invalid MessagePack passed to Notify: " + e.message;
+      throw new Error(error);
+    }
+    notifyListeners(message);
+  }
   function EventsEmit(eventName) {
     const payload = {
       name: eventName,
@@ -283,6 +433,96 @@
     });
   }
 
+  function UpdateBindings(bindingsMap) {
+    let newBindings;
+    try {
+      newBindings = JSON.parse(bindingsMap);
+    } catch (e) {
+      console.error(e);
+      return;
+    }
+    Object.keys(window.go).forEach((packageName) => {
+      if (!newBindings[packageName]) {
+        delete window.go[packageName];
+        return;
+      }
+      Object.keys(window.go[packageName]).forEach((structName) => {
+        if (!newBindings[packageName][structName]) {
+          delete window.go[packageName][structName];
+          return;
+        }
+        Object.keys(window.go[packageName][structName]).forEach((methodName) => {
+          if (!newBindings[packageName][structName][methodName]) {
+            delete window.go[packageName][structName][methodName];
+          }
+        });
+      });
+    });
+    SetBindings(bindingsMap);
+  }
+
+  // desktop/reload.js
+  var hotAssets = /\.(css|png|jpe?g|gif|svg|webp|avif|ico|bmp)$/i;
+  function isChanged(url, changedPaths) {
+    try {
+      const u = new URL(url, window.location.href);
+      return u.origin === window.location.origin && changedPaths.includes(u.pathname);
+    } catch (e) {
+      return false;
+    }
+  }
+  function cacheBust(url) {
+    const u = new URL(url, window.location.href);
+    u.searchParams.set("wailsreload", Date.now().toString());
+    return u.pathname + u.search + u.hash;
+  }
+  function ReloadAssets(changedPaths) {
+    if (!changedPaths.every((p) => hotAssets.test(p))) {
+      window.location.reload();
+      return;
+    }
+    const refreshed = /* @__PURE__ */ new Set();
+    document.querySelectorAll('link[rel="stylesheet"][href]').forEach((link) => {
+      if (isChanged(link.href, changedPaths)) {
+        refreshed.add(new URL(link.href).pathname);
+        link.href = cacheBust(link.href);
+      }
+    });
+    document.querySelectorAll("img[src]").forEach((img) => {
+      if (isChanged(img.src, changedPaths)) {
+        refreshed.add(new URL(img.src).pathname);
+        img.src = cacheBust(img.src);
+      }
+    });
+    if (refreshed.size !== changedPaths.length) {
+      window.location.reload();
+    }
+  }
+
+  // desktop/simulate.js
+  var simulate_exports = {};
+  __export(simulate_exports, {
+    FileOpen: () => FileOpen,
+    SecondInstance: () => SecondInstance,
+    ThemeChange: () => ThemeChange,
+    UrlOpen: () => UrlOpen
+  });
+  function simulate(event) {
+    window.WailsInvoke("wails:simulate:" + JSON.stringify(event));
+  }
+  function FileOpen(filePath) {
+    simulate({ type: "fileopen", filePath });
+  }
+  function UrlOpen(url) {
+    simulate({ type: "urlopen", url });
+  }
+  function SecondInstance(args, workingDirectory) {
+    simulate({ type: "secondinstance", args: args || [], workingDirectory: workingDirectory || "" });
+  }
+  function ThemeChange(theme) {
+    simulate({ type: "themechange", theme });
+  }
+
   // desktop/window.js
   var window_exports = {};
   __export(window_exports, {
@@ -290,11 +530,14 @@
     WindowFullscreen: () => WindowFullscreen,
     WindowGetPosition: () => WindowGetPosition,
     WindowGetSize: () => WindowGetSize,
+    WindowGetState: () => WindowGetState,
+    WindowGetTitle: () => WindowGetTitle,
     WindowHide: () => WindowHide,
     WindowIsFullscreen: () => WindowIsFullscreen,
     WindowIsMaximised: () => WindowIsMaximised,
     WindowIsMinimised: () => WindowIsMinimised,
     WindowIsNormal: () => WindowIsNormal,
+    WindowList: () => WindowList,
     WindowMaximise: () => WindowMaximise,
     WindowMinimise: () => WindowMinimise,
     WindowReload: () => WindowReload,
@@ -336,6 +579,9 @@
   function WindowSetTitle(title) {
     window.WailsInvoke("WT" + title);
   }
+  function WindowGetTitle() {
+    return Call(":wails:WindowGetTitle");
+  }
   function WindowFullscreen() {
     window.WailsInvoke("WF");
   }
@@ -396,6 +642,12 @@
   function WindowIsNormal() {
     return Call(":wails:WindowIsNormal");
   }
+  function WindowGetState() {
+    return Call(":wails:WindowGetState");
+  }
+  function WindowList() {
+    return Call(":wails:WindowList");
+  }
   function WindowSetBackgroundColour(R, G, B, A) {
     let rgba = JSON.stringify({ r: R || 0, g: G || 0, b: B || 0, a: A || 255 });
     window.WailsInvoke("Wr:" + rgba);
@@ -625,6 +877,12 @@
   function Environment() {
     return Call(":wails:Environment");
   }
+  function AppVersion() {
+    return Call(":wails:AppVersion");
+  }
+  function LaunchArgs() {
+    return Call(":wails:LaunchArgs");
+  }
   window.runtime = {
     ...log_exports,
     ...window_exports,
@@ -639,6 +897,8 @@
     EventsOff,
     EventsOffAll,
     Environment,
+    AppVersion,
+    LaunchArgs,
     Show,
     Hide,
     Quit
@@ -646,6 +906,7 @@
   window.wails = {
     Callback,
     EventsNotify,
+    EventsNotifyMsgpack,
     SetBindings,
     eventListeners,
     callbacks,
@@ -668,6 +929,18 @@
     window.wails.SetBindings(window.wailsbindings);
     delete window.wails.SetBindings;
   }
+  if (true) {
+    window.wails.UpdateBindings = function(bindingsMap) {
+      if (bindingsMap === window.wailsbindings) {
+        return;
+      }
+      window.wailsbindings = bindingsMap;
+      UpdateBindings(bindingsMap);
+      EventsNotify(JSON.stringify({ name: "wails:bindings:updated", data: [] }));
+    };
+    window.wails.ReloadAssets = ReloadAssets;
+    window.wails.Simulate = simulate_exports;
+  }
   if (false) {
     delete window.wailsbindings;
   }
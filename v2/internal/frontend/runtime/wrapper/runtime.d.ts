@@ -23,6 +23,7 @@ export interface Screen {
     isPrimary: boolean;
     width : number
     height : number
+    scaleFactor: number
 }
 
 // Environment information such as platform, buildtype, ...
@@ -32,6 +33,26 @@ export interface EnvironmentInfo {
     arch: string;
 }
 
+// Version details of the application, set at build time
+export interface AppVersionInfo {
+    version: string;
+    commit: string;
+    date: string;
+}
+
+export type WindowState = "normal" | "maximised" | "minimised" | "fullscreen";
+
+// A window of the application, see WindowList
+export interface WindowInfo {
+    id: string;
+    title: string;
+    x: number;
+    y: number;
+    width: number;
+    height: number;
+    state: WindowState;
+}
+
 // [EventsEmit](https://wails.io/docs/reference/runtime/events#eventsemit)
 // emits the given event. Optional data may be passed with the event.
 // This will trigger any event listeners.
@@ -120,6 +141,10 @@ export function WindowCenter(): void;
 // Sets the text in the window title bar.
 export function WindowSetTitle(title: string): void;
 
+// [WindowGetTitle](https://wails.io/docs/reference/runtime/window#windowgettitle)
+// Returns the title of the window.
+export function WindowGetTitle(): Promise<string>;
+
 // [WindowFullscreen](https://wails.io/docs/reference/runtime/window#windowfullscreen)
 // Makes the window full screen.
 export function WindowFullscreen(): void;
@@ -198,6 +223,14 @@ export function WindowIsMinimised(): Promise<boolean>;
 // Returns the state of the window, i.e. whether the window is normal or not.
 export function WindowIsNormal(): Promise<boolean>;
 
+// [WindowGetState](https://wails.io/docs/reference/runtime/window#windowgetstate)
+// Returns the state of the window: normal, maximised, minimised or fullscreen.
+export function WindowGetState(): Promise<WindowState>;
+
+// [WindowList](https://wails.io/docs/reference/runtime/window#windowlist)
+// Returns the windows of the application with their ID, title, position, size and state. Currently only the main window.
+export function WindowList(): Promise<WindowInfo[]>;
+
 // [WindowSetBackgroundColour](https://wails.io/docs/reference/runtime/window#windowsetbackgroundcolour)
 // Sets the background colour of the window to the given RGBA colour definition. This colour will show through for all transparent pixels.
 export function WindowSetBackgroundColour(R: number, G: number, B: number, A: number): void;
@@ -214,6 +247,14 @@ export function BrowserOpenURL(url: string): void;
 // Returns information about the environment
 export function Environment(): Promise<EnvironmentInfo>;
 
+// [AppVersion](https://wails.io/docs/reference/runtime/intro#appversion)
+// Returns the version details of the application
+export function AppVersion(): Promise<AppVersionInfo>;
+
+// [LaunchArgs](https://wails.io/docs/reference/runtime/intro#launchargs)
+// Returns the command line arguments the application was launched with
+export function LaunchArgs(): Promise<string[]>;
+
 // [Quit](https://wails.io/docs/reference/runtime/intro#quit)
 // Quits the application.
 export function Quit(): void;
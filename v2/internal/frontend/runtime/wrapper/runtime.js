@@ -93,6 +93,10 @@ export function WindowSetTitle(title) {
     window.runtime.WindowSetTitle(title);
 }
 
+export function WindowGetTitle() {
+    return window.runtime.WindowGetTitle();
+}
+
 export function WindowFullscreen() {
     window.runtime.WindowFullscreen();
 }
@@ -177,6 +181,14 @@ export function WindowIsNormal() {
     return window.runtime.WindowIsNormal();
 }
 
+export function WindowGetState() {
+    return window.runtime.WindowGetState();
+}
+
+export function WindowList() {
+    return window.runtime.WindowList();
+}
+
 export function BrowserOpenURL(url) {
     window.runtime.BrowserOpenURL(url);
 }
@@ -185,6 +197,14 @@ export function Environment() {
     return window.runtime.Environment();
 }
 
+export function AppVersion() {
+    return window.runtime.AppVersion();
+}
+
+export function LaunchArgs() {
+    return window.runtime.LaunchArgs();
+}
+
 export function Quit() {
     window.runtime.Quit();
 }
@@ -0,0 +1,65 @@
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// SimulatedEventPrefix is the prefix of messages sent by the frontend to simulate OS events.
+// These are only processed in debug builds and are intended for UI testing.
+const SimulatedEventPrefix = "wails:simulate:"
+
+type SimulatedEventType string
+
+const (
+	SimulatedFileOpen       SimulatedEventType = "fileopen"
+	SimulatedURLOpen        SimulatedEventType = "urlopen"
+	SimulatedSecondInstance SimulatedEventType = "secondinstance"
+	SimulatedThemeChange    SimulatedEventType = "themechange"
+)
+
+// SimulatedEvent is a synthetic OS event sent by the frontend
+type SimulatedEvent struct {
+	Type             SimulatedEventType `json:"type"`
+	FilePath         string             `json:"filePath"`
+	URL              string             `json:"url"`
+	Args             []string           `json:"args"`
+	WorkingDirectory string             `json:"workingDirectory"`
+	Theme            string             `json:"theme"`
+}
+
+// SecondInstanceData returns the second instance data carried by the event
+func (e *SimulatedEvent) SecondInstanceData() options.SecondInstanceData {
+	return options.SecondInstanceData{
+		Args:             e.Args,
+		WorkingDirectory: e.WorkingDirectory,
+	}
+}
+
+// ParseSimulatedEvent decodes a message starting with SimulatedEventPrefix
+func ParseSimulatedEvent(message string) (*SimulatedEvent, error) {
+	var event SimulatedEvent
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(message, SimulatedEventPrefix)), &event); err != nil {
+		return nil, fmt.Errorf("invalid simulated event '%s': %w", message, err)
+	}
+	return &event, nil
+}
+
+// SimulateThemeChange switches the window theme as if the OS theme changed to the given theme:
+// "dark", "light" or "system"
+func SimulateThemeChange(f Frontend, theme string) error {
+	switch theme {
+	case "dark":
+		f.WindowSetDarkTheme()
+	case "light":
+		f.WindowSetLightTheme()
+	case "system":
+		f.WindowSetSystemDefaultTheme()
+	default:
+		return fmt.Errorf("unknown theme '%s' for simulated theme change", theme)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package frontend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// InfoLogger logs the startup timings
+type InfoLogger interface {
+	Info(format string, args ...interface{})
+}
+
+// StartupTimer logs the time from the launch of the application by `wails dev` until the frontend has sent the
+// DomReady and runtime:ready messages. After a reload, the time since the reload is logged
+type StartupTimer struct {
+	lock   sync.Mutex
+	logger InfoLogger
+	start  time.Time
+	since  string
+	logged map[string]bool
+}
+
+// NewStartupTimer returns the timer for the launch time of the context set by `wails dev`, nil without it
+func NewStartupTimer(ctx context.Context, logger InfoLogger) *StartupTimer {
+	launchTime, ok := ctx.Value("launchtime").(time.Time)
+	if !ok {
+		return nil
+	}
+	result := &StartupTimer{logger: logger}
+	result.restart(launchTime, "launch")
+	return result
+}
+
+// Reloading restarts the timer because the frontend gets reloaded. It may be called on a nil timer
+func (t *StartupTimer) Reloading() {
+	if t == nil {
+		return
+	}
+	t.restart(time.Now(), "reload")
+}
+
+// Ready logs the time until the message has been received for the first time since the launch or reload.
+// It may be called on a nil timer
+func (t *StartupTimer) Ready(message string) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.logged[message] {
+		return
+	}
+	t.logged[message] = true
+	t.logger.Info("Frontend timing: %s %dms after the %s", message, time.Since(t.start).Milliseconds(), t.since)
+}
+
+func (t *StartupTimer) restart(start time.Time, since string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.start = start
+	t.since = since
+	t.logged = map[string]bool{}
+}
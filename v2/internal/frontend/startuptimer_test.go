@@ -0,0 +1,40 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type infoLogger struct {
+	infos []string
+}
+
+func (i *infoLogger) Info(format string, args ...interface{}) {
+	i.infos = append(i.infos, fmt.Sprintf(format, args...))
+}
+
+func TestStartupTimer(t *testing.T) {
+	var nilTimer *StartupTimer
+	nilTimer.Reloading()
+	nilTimer.Ready("DomReady")
+	require.Nil(t, NewStartupTimer(context.Background(), &infoLogger{}))
+
+	logger := &infoLogger{}
+	ctx := context.WithValue(context.Background(), "launchtime", time.Now().Add(-2*time.Second))
+	timer := NewStartupTimer(ctx, logger)
+	timer.Ready("runtime:ready")
+	timer.Ready("DomReady")
+	timer.Ready("DomReady")
+	require.Len(t, logger.infos, 2)
+	require.Regexp(t, `^Frontend timing: runtime:ready 2\d{3}ms after the launch$`, logger.infos[0])
+	require.Regexp(t, `^Frontend timing: DomReady 2\d{3}ms after the launch$`, logger.infos[1])
+
+	timer.Reloading()
+	timer.Ready("DomReady")
+	require.Len(t, logger.infos, 3)
+	require.Regexp(t, `^Frontend timing: DomReady \d+ms after the reload$`, logger.infos[2])
+}
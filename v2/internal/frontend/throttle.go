@@ -0,0 +1,69 @@
+package frontend
+
+import (
+	"sync"
+	"time"
+)
+
+// NotifyThrottle limits how often events are sent, per event name. Events sent within the interval
+// of the previous one are dropped, except the latest, which is sent when the interval ends.
+// The zero value is ready to use
+type NotifyThrottle struct {
+	lock   sync.Mutex
+	events map[string]*throttledEvent
+}
+
+type throttledEvent struct {
+	last    time.Time
+	data    []interface{}
+	pending bool
+}
+
+// Notify sends the event with notify, or keeps it for the trailing send if an event with the same
+// name was sent less than minInterval ago
+func (t *NotifyThrottle) Notify(name string, minInterval time.Duration, data []interface{}, notify func(name string, data ...interface{})) {
+	t.lock.Lock()
+	if t.events == nil {
+		t.events = map[string]*throttledEvent{}
+	}
+	event := t.events[name]
+	if event == nil {
+		event = &throttledEvent{}
+		t.events[name] = event
+	}
+
+	now := time.Now()
+	wait := minInterval - now.Sub(event.last)
+	if wait <= 0 && !event.pending {
+		event.last = now
+		t.lock.Unlock()
+		notify(name, data...)
+		return
+	}
+
+	event.data = data
+	if !event.pending {
+		event.pending = true
+		time.AfterFunc(wait, func() {
+			t.flush(name, notify)
+		})
+	}
+	t.lock.Unlock()
+}
+
+// flush sends the latest event kept by Notify
+func (t *NotifyThrottle) flush(name string, notify func(name string, data ...interface{})) {
+	t.lock.Lock()
+	event := t.events[name]
+	if event == nil || !event.pending {
+		t.lock.Unlock()
+		return
+	}
+	data := event.data
+	event.data = nil
+	event.pending = false
+	event.last = time.Now()
+	t.lock.Unlock()
+
+	notify(name, data...)
+}
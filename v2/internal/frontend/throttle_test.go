@@ -0,0 +1,58 @@
+package frontend
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotify struct {
+	lock     sync.Mutex
+	received map[string][]interface{}
+}
+
+func (r *recordingNotify) notify(name string, data ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.received == nil {
+		r.received = map[string][]interface{}{}
+	}
+	r.received[name] = append(r.received[name], data...)
+}
+
+func (r *recordingNotify) get(name string) []interface{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.received[name]
+}
+
+func TestNotifyThrottle(t *testing.T) {
+	var throttle NotifyThrottle
+	var recorder recordingNotify
+
+	for i := 1; i <= 5; i++ {
+		throttle.Notify("progress", 50*time.Millisecond, []interface{}{i}, recorder.notify)
+	}
+	throttle.Notify("other", 50*time.Millisecond, []interface{}{"a"}, recorder.notify)
+
+	// The first event is sent straight away, the others are dropped until the interval ends
+	require.Equal(t, []interface{}{1}, recorder.get("progress"))
+	require.Equal(t, []interface{}{"a"}, recorder.get("other"))
+
+	// The latest event is sent at the end of the interval
+	require.Eventually(t, func() bool {
+		return len(recorder.get("progress")) == 2
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, []interface{}{1, 5}, recorder.get("progress"))
+	require.Equal(t, []interface{}{"a"}, recorder.get("other"))
+
+	// Nothing is sent without a new event
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []interface{}{1, 5}, recorder.get("progress"))
+
+	// After the interval the next event is sent straight away again
+	throttle.Notify("progress", 50*time.Millisecond, []interface{}{6}, recorder.notify)
+	require.Equal(t, []interface{}{1, 5, 6}, recorder.get("progress"))
+}
@@ -0,0 +1,149 @@
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// WarningLogger logs the diagnostics of the watchdogs
+type WarningLogger interface {
+	Warning(format string, args ...interface{})
+}
+
+// StartupWatchdog reports a diagnostic if the frontend doesn't signal it's ready within the timeout of
+// options.App.StartupWatchdog, EG: because of a broken bundle or a missing index.html
+type StartupWatchdog struct {
+	timer *time.Timer
+}
+
+// StartStartupWatchdog starts the watchdog for the page at the url. It returns nil if the watchdog isn't enabled
+func StartStartupWatchdog(f Frontend, appoptions *options.App, logger WarningLogger, url string) *StartupWatchdog {
+	config := appoptions.StartupWatchdog
+	if config == nil {
+		return nil
+	}
+
+	return &StartupWatchdog{
+		timer: time.AfterFunc(config.Timeout, func() {
+			diagnostic := StartupDiagnostic(url, config.Timeout)
+			logger.Warning("%s", diagnostic)
+			if config.ShowDialog {
+				_, _ = f.MessageDialog(MessageDialogOptions{
+					Type:    WarningDialog,
+					Title:   "The application didn't start",
+					Message: diagnostic,
+				})
+			}
+		}),
+	}
+}
+
+// Ready stops the watchdog. It may be called on a nil watchdog
+func (w *StartupWatchdog) Ready() {
+	if w == nil {
+		return
+	}
+	w.timer.Stop()
+}
+
+// StartupDiagnostic describes the likely causes of a frontend which didn't become ready
+func StartupDiagnostic(url string, timeout time.Duration) string {
+	return fmt.Sprintf(`The frontend at %s didn't become ready within %s. Likely causes:
+- The index.html is missing from the assets, EG: the frontend hasn't been built or the embed path is wrong
+- A script or stylesheet of the page returned 404, check the network tab of the devtools
+- A script of the page threw an error before the runtime was loaded, check the console of the devtools
+- The page doesn't load "/wails/runtime.js" and "/wails/ipc.js"
+- The external frontend dev server isn't running or is still starting`, url, timeout)
+}
+
+const (
+	// defaultMainLoopWatchdogThreshold is used if options.Debug.MainLoopWatchdogThreshold is 0
+	defaultMainLoopWatchdogThreshold = 500 * time.Millisecond
+	// mainLoopWatchdogInterval is how often the main loop is pinged
+	mainLoopWatchdogInterval = time.Second
+)
+
+// MainLoopWatchdog pings the main loop from a background goroutine and logs a diagnostic if it doesn't respond within
+// the threshold of options.Debug.MainLoopWatchdogThreshold, EG: because heavy work runs synchronously on the main
+// thread, which freezes the UI
+type MainLoopWatchdog struct {
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// StartMainLoopWatchdog starts the watchdog, which pings the main loop with dispatch. dispatch must run the function it
+// is given on the main thread without waiting for it. It returns nil if debug is false, EG: in production builds, or if
+// the watchdog has been disabled
+func StartMainLoopWatchdog(debug bool, appoptions *options.App, logger WarningLogger, dispatch func(func())) *MainLoopWatchdog {
+	threshold := appoptions.Debug.MainLoopWatchdogThreshold
+	if !debug || threshold < 0 {
+		return nil
+	}
+	if threshold == 0 {
+		threshold = defaultMainLoopWatchdogThreshold
+	}
+
+	w := &MainLoopWatchdog{stop: make(chan struct{})}
+	go w.run(threshold, mainLoopWatchdogInterval, logger, dispatch)
+	return w
+}
+
+func (w *MainLoopWatchdog) run(threshold time.Duration, interval time.Duration, logger WarningLogger, dispatch func(func())) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-w.stop:
+			return
+		}
+
+		start := time.Now()
+		pong := make(chan struct{})
+		dispatch(func() {
+			close(pong)
+		})
+
+		timer := time.NewTimer(threshold)
+		select {
+		case <-pong:
+			timer.Stop()
+			continue
+		case <-w.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		logger.Warning("%s", MainLoopDiagnostic(threshold))
+		select {
+		case <-pong:
+			logger.Warning("The main loop responded again after %s", time.Since(start).Round(time.Millisecond))
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// Stop stops the watchdog, EG: when the main loop has exited. It may be called on a nil watchdog
+func (w *MainLoopWatchdog) Stop() {
+	if w == nil {
+		return
+	}
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+// MainLoopDiagnostic describes the likely causes of a main loop which didn't respond within the threshold
+func MainLoopDiagnostic(threshold time.Duration) string {
+	return fmt.Sprintf(`The main loop didn't respond within %s, the UI is frozen meanwhile. Likely causes:
+- Heavy work in a function passed to runtime.RunOnMainThread, do it before and only pass the native calls
+- A blocking call on the main thread, EG: waiting for a channel, a lock or the network in a cgo callback
+- A runtime method which waits for the main thread called on it, EG: a dialog
+This is expected once after the application has been paused in a debugger. The watchdog only runs in debug builds,
+set options.Debug.MainLoopWatchdogThreshold to change the threshold or to disable it`, threshold)
+}
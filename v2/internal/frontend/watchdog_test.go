@@ -0,0 +1,142 @@
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+type dialogFrontend struct {
+	Frontend
+
+	dialogs chan MessageDialogOptions
+}
+
+func (d *dialogFrontend) MessageDialog(dialogOptions MessageDialogOptions) (string, error) {
+	d.dialogs <- dialogOptions
+	return "", nil
+}
+
+type warningLogger struct {
+	lock     sync.Mutex
+	warnings []string
+}
+
+func (w *warningLogger) Warning(format string, args ...interface{}) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.warnings = append(w.warnings, fmt.Sprintf(format, args...))
+}
+
+func (w *warningLogger) get() []string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.warnings
+}
+
+func TestStartupWatchdogTimeout(t *testing.T) {
+	f := &dialogFrontend{dialogs: make(chan MessageDialogOptions, 1)}
+	logger := &warningLogger{}
+	appoptions := &options.App{
+		StartupWatchdog: &options.StartupWatchdog{
+			Timeout:    10 * time.Millisecond,
+			ShowDialog: true,
+		},
+	}
+
+	StartStartupWatchdog(f, appoptions, logger, "wails://wails/")
+
+	select {
+	case dialog := <-f.dialogs:
+		require.Equal(t, WarningDialog, dialog.Type)
+		require.Contains(t, dialog.Message, "wails://wails/")
+	case <-time.After(time.Second):
+		t.Fatal("no diagnostic dialog shown")
+	}
+	require.Len(t, logger.get(), 1)
+	require.Contains(t, logger.get()[0], "didn't become ready within 10ms")
+}
+
+func TestStartupWatchdogReady(t *testing.T) {
+	f := &dialogFrontend{dialogs: make(chan MessageDialogOptions, 1)}
+	logger := &warningLogger{}
+	appoptions := &options.App{
+		StartupWatchdog: &options.StartupWatchdog{Timeout: 20 * time.Millisecond},
+	}
+
+	watchdog := StartStartupWatchdog(f, appoptions, logger, "wails://wails/")
+	watchdog.Ready()
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, logger.get())
+
+	// Disabled
+	watchdog = StartStartupWatchdog(f, &options.App{}, logger, "wails://wails/")
+	require.Nil(t, watchdog)
+	watchdog.Ready()
+}
+
+func TestMainLoopWatchdog(t *testing.T) {
+	mainLoop := make(chan func(), 10)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case fn := <-mainLoop:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		close(done)
+	})
+	dispatch := func(fn func()) {
+		select {
+		case mainLoop <- fn:
+		case <-done:
+		}
+	}
+	logger := &warningLogger{}
+
+	watchdog := &MainLoopWatchdog{stop: make(chan struct{})}
+	go watchdog.run(20*time.Millisecond, 5*time.Millisecond, logger, dispatch)
+	defer watchdog.Stop()
+
+	// A responsive main loop
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, logger.get())
+
+	// A blocked main loop is reported once, and again when it responds
+	dispatch(func() {
+		time.Sleep(100 * time.Millisecond)
+	})
+	require.Eventually(t, func() bool {
+		return len(logger.get()) == 2
+	}, time.Second, 5*time.Millisecond)
+	require.Contains(t, logger.get()[0], "The main loop didn't respond within 20ms")
+	require.Contains(t, logger.get()[1], "The main loop responded again after")
+
+	watchdog.Stop()
+	watchdog.Stop()
+}
+
+func TestStartMainLoopWatchdogDisabled(t *testing.T) {
+	logger := &warningLogger{}
+	dispatch := func(fn func()) {}
+
+	require.Nil(t, StartMainLoopWatchdog(false, &options.App{}, logger, dispatch))
+	appoptions := &options.App{Debug: options.Debug{MainLoopWatchdogThreshold: -1}}
+	require.Nil(t, StartMainLoopWatchdog(true, appoptions, logger, dispatch))
+
+	watchdog := StartMainLoopWatchdog(true, &options.App{}, logger, dispatch)
+	require.NotNil(t, watchdog)
+	watchdog.Stop()
+
+	var disabled *MainLoopWatchdog
+	disabled.Stop()
+}
@@ -0,0 +1,76 @@
+package frontend
+
+import "fmt"
+
+type WindowState string
+
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMaximised  WindowState = "maximised"
+	WindowStateMinimised  WindowState = "minimised"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// WindowInfo describes an application window
+type WindowInfo struct {
+	ID     string      `json:"id"`
+	Title  string      `json:"title"`
+	X      int         `json:"x"`
+	Y      int         `json:"y"`
+	Width  int         `json:"width"`
+	Height int         `json:"height"`
+	State  WindowState `json:"state"`
+}
+
+// WindowActiveJS returns the script which adds the "wails-inactive" class to the root element of the document while
+// the window isn't the active one, so that custom title bars can be dimmed like the native ones
+func WindowActiveJS(active bool) string {
+	return fmt.Sprintf("document.documentElement.classList.toggle('wails-inactive', %t);", !active)
+}
+
+// MainWindowID is the ID of the main application window
+const MainWindowID = "main"
+
+// WindowGetState returns the current state of the frontend's window
+func WindowGetState(f Frontend) WindowState {
+	switch {
+	case f.WindowIsFullscreen():
+		return WindowStateFullscreen
+	case f.WindowIsMinimised():
+		return WindowStateMinimised
+	case f.WindowIsMaximised():
+		return WindowStateMaximised
+	default:
+		return WindowStateNormal
+	}
+}
+
+// WindowList returns the windows of the frontend. Currently this is only the main window
+func WindowList(f Frontend) []WindowInfo {
+	x, y := f.WindowGetPosition()
+	width, height := f.WindowGetSize()
+	return []WindowInfo{
+		{
+			ID:     MainWindowID,
+			Title:  f.WindowGetTitle(),
+			X:      x,
+			Y:      y,
+			Width:  width,
+			Height: height,
+			State:  WindowGetState(f),
+		},
+	}
+}
+
+// ScreenFractionSize returns the size in logical pixels of the given fractions of the current screen.
+// ok is false if there is no current screen
+func ScreenFractionSize(screens []Screen, widthFraction float64, heightFraction float64) (width int, height int, ok bool) {
+	for _, screen := range screens {
+		if screen.IsCurrent {
+			width = int(float64(screen.Size.Width) * widthFraction)
+			height = int(float64(screen.Size.Height) * heightFraction)
+			return width, height, true
+		}
+	}
+	return 0, 0, false
+}
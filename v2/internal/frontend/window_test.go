@@ -0,0 +1,27 @@
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindowActiveJS(t *testing.T) {
+	require.Equal(t, "document.documentElement.classList.toggle('wails-inactive', false);", WindowActiveJS(true))
+	require.Equal(t, "document.documentElement.classList.toggle('wails-inactive', true);", WindowActiveJS(false))
+}
+
+func TestScreenFractionSize(t *testing.T) {
+	screens := []Screen{
+		{IsPrimary: true, Size: ScreenSize{Width: 2560, Height: 1440}},
+		{IsCurrent: true, Size: ScreenSize{Width: 1920, Height: 1080}},
+	}
+
+	width, height, ok := ScreenFractionSize(screens, 0.5, 0.25)
+	require.True(t, ok)
+	require.Equal(t, 960, width)
+	require.Equal(t, 270, height)
+
+	_, _, ok = ScreenFractionSize(screens[:1], 0.5, 0.5)
+	require.False(t, ok, "no current screen")
+}
@@ -0,0 +1,42 @@
+package frontend
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// SavedWindowState is the geometry and state of a window, saved on quit to restore the window on the next start.
+// The geometry is the one of the normal window, so un-maximising a restored window returns to it
+type SavedWindowState struct {
+	X      int         `json:"x"`
+	Y      int         `json:"y"`
+	Width  int         `json:"width"`
+	Height int         `json:"height"`
+	State  WindowState `json:"state"`
+}
+
+// LoadWindowState reads the window state saved at the given path
+func LoadWindowState(path string) (*SavedWindowState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state SavedWindowState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// Save writes the window state to the given path, creating its directory if needed
+func (s *SavedWindowState) Save(path string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,23 @@
+package frontend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedWindowState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app", "window.json")
+
+	_, err := LoadWindowState(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	saved := &SavedWindowState{X: 10, Y: 20, Width: 1024, Height: 768, State: WindowStateFullscreen}
+	require.NoError(t, saved.Save(path))
+
+	loaded, err := LoadWindowState(path)
+	require.NoError(t, err)
+	require.Equal(t, saved, loaded)
+}
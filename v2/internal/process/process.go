@@ -23,6 +23,12 @@ func NewProcess(cmd string, args ...string) *Process {
 	return result
 }
 
+// SetEnv sets the environment of the process in the form "key=value". The process inherits the environment of the
+// current process if env is nil
+func (p *Process) SetEnv(env []string) {
+	p.cmd.Env = env
+}
+
 // Start the process
 func (p *Process) Start(exitCodeChannel chan int) error {
 	err := p.cmd.Start()
@@ -18,6 +18,8 @@ type Project struct {
 
 	ReloadDirectories string `json:"reloaddirs,omitempty"`
 
+	WatchDirectories string `json:"watchdirs,omitempty"`
+
 	BuildCommand   string `json:"frontend:build"`
 	InstallCommand string `json:"frontend:install"`
 
@@ -26,6 +28,8 @@ type Project struct {
 	DevBuildCommand   string `json:"frontend:dev:build"`
 	DevInstallCommand string `json:"frontend:dev:install"`
 	DevWatcherCommand string `json:"frontend:dev:watcher"`
+	// Additional watcher commands started together with DevWatcherCommand, EG: a Tailwind watcher next to Vite
+	DevWatcherCommands []string `json:"frontend:dev:watchers,omitempty"`
 	// The url of the external wails dev server. If this is set, this server is used for the frontend. Default ""
 	FrontendDevServerURL string `json:"frontend:dev:serverUrl"`
 
@@ -99,6 +103,9 @@ type Project struct {
 	// The timeout in seconds for Vite server detection. Default 10
 	ViteServerTimeout int `json:"viteServerTimeout"`
 
+	// Defaults for the `wails dev` flags, keyed by the flag name. EG: {"debounce": 200, "extensions": "go,ts"}
+	DevFlags map[string]json.RawMessage `json:"dev,omitempty"`
+
 	Bindings Bindings `json:"bindings"`
 }
 
@@ -140,6 +147,17 @@ func (p *Project) GetDevInstallerCommand() string {
 	return p.InstallCommand
 }
 
+// GetDevWatcherCommands returns the DevWatcherCommand followed by the DevWatcherCommands, without empty commands
+func (p *Project) GetDevWatcherCommands() []string {
+	var result []string
+	for _, command := range append([]string{p.DevWatcherCommand}, p.DevWatcherCommands...) {
+		if strings.TrimSpace(command) != "" {
+			result = append(result, command)
+		}
+	}
+	return result
+}
+
 func (p *Project) IsFrontendDevServerURLAutoDiscovery() bool {
 	return p.FrontendDevServerURL == "auto"
 }
@@ -3,6 +3,7 @@ package project_test
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"testing"
 
@@ -140,3 +141,44 @@ func TestProject_GetBuildDir(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_GetDevWatcherCommands(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      []string
+	}{
+		{
+			name:      "Should return nothing by default",
+			inputJSON: "{}",
+			want:      nil,
+		},
+		{
+			name:      "Should return the single watcher",
+			inputJSON: `{"frontend:dev:watcher": "npm run dev"}`,
+			want:      []string{"npm run dev"},
+		},
+		{
+			name:      "Should return the watcher followed by the additional watchers",
+			inputJSON: `{"frontend:dev:watcher": "npm run dev", "frontend:dev:watchers": ["npm run tailwind", ""]}`,
+			want:      []string{"npm run dev", "npm run tailwind"},
+		},
+		{
+			name:      "Should support only additional watchers",
+			inputJSON: `{"frontend:dev:watchers": ["npm run tailwind", "npm run dev"]}`,
+			want:      []string{"npm run tailwind", "npm run dev"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proj, err := project.Parse([]byte(tt.inputJSON))
+			if err != nil {
+				t.Fatalf("Error parsing project: %s", err)
+			}
+			got := proj.GetDevWatcherCommands()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDevWatcherCommands() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -34,6 +34,12 @@ type assetHandler struct {
 
 	logger Logger
 
+	// Content-Types by lowercase file extension, which take precedence over the detected types
+	mimeTypes map[string]string
+
+	// The URL path of the service worker script
+	serviceWorker string
+
 	retryMissingFiles bool
 }
 
@@ -65,12 +71,17 @@ func NewAssetHandler(options assetserver.Options, log Logger) (http.Handler, err
 		}
 	}
 
-	var result http.Handler = &assetHandler{
-		fs:      vfs,
-		handler: options.Handler,
-		logger:  log,
+	handler := &assetHandler{
+		fs:        vfs,
+		handler:   options.Handler,
+		logger:    log,
+		mimeTypes: normaliseMimeTypes(options.MimeTypes),
+	}
+	if sw := options.ServiceWorker; sw != "" {
+		handler.serviceWorker = "/" + strings.TrimPrefix(sw, "/")
 	}
 
+	var result http.Handler = handler
 	if middleware := options.Middleware; middleware != nil {
 		result = middleware(result)
 	}
@@ -85,6 +96,11 @@ func (d *assetHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		filename := path.Clean(strings.TrimPrefix(url, "/"))
 
 		d.logDebug("Handling request '%s' (file='%s')", url, filename)
+		if url == d.serviceWorker {
+			// Allow the service worker to control the whole app, even if it's not served from the root
+			rw.Header().Set(HeaderServiceWorkerAllowed, "/")
+			rw.Header().Set(HeaderCacheControl, "no-cache")
+		}
 		if err := d.serveFSFile(rw, req, filename); err != nil {
 			if os.IsNotExist(err) {
 				if handler != nil {
@@ -155,7 +171,11 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 
 	var buf [512]byte
 	var n int
-	if _, haveType := rw.Header()[HeaderContentType]; !haveType {
+	if _, haveType := rw.Header()[HeaderContentType]; haveType {
+		// The Content-Type has been set by a middleware
+	} else if contentType := d.mimeTypes[strings.ToLower(path.Ext(filename))]; contentType != "" {
+		rw.Header().Set(HeaderContentType, contentType)
+	} else {
 		// Detect MimeType by sniffing the first 512 bytes
 		n, err = file.Read(buf[:])
 		if err != nil && err != io.EOF {
@@ -178,6 +198,28 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 		return nil
 	}
 
+	// The file can't seek, so a range is served by skipping the content before it
+	content := io.MultiReader(bytes.NewReader(buf[:n]), file)
+	rw.Header().Set(HeaderAcceptRanges, "bytes")
+	if rangeHeader := req.Header.Get(HeaderRange); rangeHeader != "" && req.Header.Get(HeaderIfRange) == "" {
+		start, length, ok, err := parseByteRange(rangeHeader, statInfo.Size())
+		if err != nil {
+			rw.Header().Set(HeaderContentRange, fmt.Sprintf("bytes */%d", statInfo.Size()))
+			rw.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return nil
+		}
+		if ok {
+			rw.Header().Set(HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, statInfo.Size()))
+			rw.Header().Set(HeaderContentLength, strconv.FormatInt(length, 10))
+			rw.WriteHeader(http.StatusPartialContent)
+			if _, err := io.CopyN(io.Discard, content, start); err != nil {
+				return err
+			}
+			_, err = io.CopyN(rw, content, length)
+			return err
+		}
+	}
+
 	size := strconv.FormatInt(statInfo.Size(), 10)
 	rw.Header().Set(HeaderContentLength, size)
 
@@ -192,6 +234,73 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 	return err
 }
 
+// normaliseMimeTypes returns the Content-Types by lowercase extension with a leading "."
+func normaliseMimeTypes(mimeTypes map[string]string) map[string]string {
+	result := make(map[string]string, len(mimeTypes))
+	for ext, mimeType := range mimeTypes {
+		ext = strings.ToLower(ext)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		result[ext] = mimeType
+	}
+	return result
+}
+
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// parseByteRange parses a Range header with a single byte range and returns the start and length of the range.
+// ok is false if the header is invalid or contains multiple ranges, which are answered with the whole content.
+func parseByteRange(header string, size int64) (start int64, length int64, ok bool, err error) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(header, prefix) {
+		return 0, 0, false, nil
+	}
+	spec := strings.TrimSpace(strings.TrimPrefix(header, prefix))
+	if strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+	startSpec, endSpec, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, false, nil
+	}
+	startSpec, endSpec = strings.TrimSpace(startSpec), strings.TrimSpace(endSpec)
+
+	if startSpec == "" {
+		// A suffix range with the last bytes of the content
+		suffix, err := strconv.ParseInt(endSpec, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, 0, false, nil
+		}
+		if suffix == 0 || size == 0 {
+			return 0, 0, false, errRangeNotSatisfiable
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, suffix, true, nil
+	}
+
+	start, err = strconv.ParseInt(startSpec, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, nil
+	}
+	if start >= size {
+		return 0, 0, false, errRangeNotSatisfiable
+	}
+	end := size - 1
+	if endSpec != "" {
+		requestedEnd, err := strconv.ParseInt(endSpec, 10, 64)
+		if err != nil || requestedEnd < start {
+			return 0, 0, false, nil
+		}
+		if requestedEnd < end {
+			end = requestedEnd
+		}
+	}
+	return start, end - start + 1, true, nil
+}
+
 func (d *assetHandler) logDebug(message string, args ...interface{}) {
 	if d.logger != nil {
 		d.logger.Debug("[AssetHandler] "+message, args...)
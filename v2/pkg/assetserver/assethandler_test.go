@@ -0,0 +1,181 @@
+package assetserver
+
+import (
+	iofs "io/fs"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/assetserver/testdata"
+	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+// nonSeekableFS hides the io.Seeker of the files, like many fs.FS implementations that stream their content
+type nonSeekableFS struct {
+	iofs.FS
+}
+
+type nonSeekableFile struct {
+	file iofs.File
+}
+
+func (f nonSeekableFile) Stat() (iofs.FileInfo, error) { return f.file.Stat() }
+func (f nonSeekableFile) Read(buf []byte) (int, error) { return f.file.Read(buf) }
+func (f nonSeekableFile) Close() error                 { return f.file.Close() }
+
+func (fsys nonSeekableFS) Open(name string) (iofs.File, error) {
+	file, err := fsys.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return nonSeekableFile{file: file}, nil
+}
+
+func TestServeRangeRequest(t *testing.T) {
+	css, err := os.ReadFile("testdata/main.css")
+	require.NoError(t, err)
+	size := len(css)
+
+	filesystems := map[string]iofs.FS{
+		"seekable":     testdata.TopLevelFS,
+		"not seekable": nonSeekableFS{testdata.TopLevelFS},
+	}
+	tests := []struct {
+		name             string
+		rangeHeader      string
+		wantCode         int
+		wantContentRange string
+		wantBody         []byte
+	}{
+		{
+			name:             "single range",
+			rangeHeader:      "bytes=10-19",
+			wantCode:         http.StatusPartialContent,
+			wantContentRange: "bytes 10-19/6051",
+			wantBody:         css[10:20],
+		},
+		{
+			name:             "open ended range",
+			rangeHeader:      "bytes=6000-",
+			wantCode:         http.StatusPartialContent,
+			wantContentRange: "bytes 6000-6050/6051",
+			wantBody:         css[6000:],
+		},
+		{
+			name:             "suffix range",
+			rangeHeader:      "bytes=-5",
+			wantCode:         http.StatusPartialContent,
+			wantContentRange: "bytes 6046-6050/6051",
+			wantBody:         css[size-5:],
+		},
+		{
+			name:             "unsatisfiable range",
+			rangeHeader:      "bytes=7000-7100",
+			wantCode:         http.StatusRequestedRangeNotSatisfiable,
+			wantContentRange: "bytes */6051",
+		},
+		{
+			name:     "no range",
+			wantCode: http.StatusOK,
+			wantBody: css,
+		},
+	}
+	for fsName, fsys := range filesystems {
+		for _, tt := range tests {
+			t.Run(fsName+" "+tt.name, func(t *testing.T) {
+				server, err := NewAssetServer("", assetserver.Options{Assets: fsys}, false, testLogger{}, testRuntimeAssets{})
+				require.NoError(t, err)
+				server.ExpectedWebViewHost = "wails.localhost"
+
+				header := http.Header{HeaderHost: []string{"wails.localhost"}}
+				if tt.rangeHeader != "" {
+					header.Set(HeaderRange, tt.rangeHeader)
+				}
+				response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/main.css", header, nil))
+
+				require.Equal(t, tt.wantCode, response.Code())
+				if tt.wantCode != http.StatusRequestedRangeNotSatisfiable {
+					require.Equal(t, "bytes", response.Header().Get(HeaderAcceptRanges))
+				}
+				require.Equal(t, tt.wantContentRange, response.Header().Get(HeaderContentRange))
+				if tt.wantBody != nil {
+					require.Equal(t, string(tt.wantBody), string(response.Body()))
+				}
+			})
+		}
+	}
+}
+
+func TestParseByteRange(t *testing.T) {
+	tests := []struct {
+		header     string
+		wantStart  int64
+		wantLength int64
+		wantOK     bool
+		wantErr    error
+	}{
+		{header: "bytes=0-0", wantStart: 0, wantLength: 1, wantOK: true},
+		{header: "bytes=90-200", wantStart: 90, wantLength: 10, wantOK: true},
+		{header: "bytes=-200", wantStart: 0, wantLength: 100, wantOK: true},
+		{header: "bytes=100-", wantErr: errRangeNotSatisfiable},
+		{header: "bytes=-0", wantErr: errRangeNotSatisfiable},
+		{header: "bytes=0-1,5-6"},
+		{header: "bytes=5-1"},
+		{header: "items=0-1"},
+		{header: "bytes=a-b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			start, length, ok, err := parseByteRange(tt.header, 100)
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantStart, start)
+			require.Equal(t, tt.wantLength, length)
+		})
+	}
+}
+
+func TestServeMimeTypeOverride(t *testing.T) {
+	options := assetserver.Options{
+		Assets: testdata.TopLevelFS,
+		// ".css" would be detected by its extension, "JS" checks that extensions are case-insensitive
+		MimeTypes: map[string]string{".css": "text/x-custom-css", "JS": "application/x-custom-js"},
+	}
+	server, err := NewAssetServer("", options, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+
+	tests := map[string]string{
+		"/main.css": "text/x-custom-css",
+		"/main.js":  "application/x-custom-js",
+	}
+	for url, wantContentType := range tests {
+		header := http.Header{HeaderHost: []string{"wails.localhost"}}
+		response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost"+url, header, nil))
+		require.Equal(t, http.StatusOK, response.Code())
+		require.Equal(t, wantContentType, response.Header().Get(HeaderContentType))
+	}
+}
+
+func TestServeServiceWorker(t *testing.T) {
+	options := assetserver.Options{
+		Assets:        testdata.TopLevelFS,
+		ServiceWorker: "main.js",
+	}
+	server, err := NewAssetServer("", options, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+
+	tests := map[string]string{
+		"/main.js":  "/",
+		"/main.css": "",
+	}
+	for url, wantAllowed := range tests {
+		header := http.Header{HeaderHost: []string{"wails.localhost"}}
+		response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost"+url, header, nil))
+		require.Equal(t, http.StatusOK, response.Code())
+		require.Equal(t, wantAllowed, response.Header().Get(HeaderServiceWorkerAllowed), url)
+	}
+}
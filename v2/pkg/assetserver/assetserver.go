@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"path"
 	"strings"
 
 	"golang.org/x/net/html"
@@ -47,6 +48,12 @@ type AssetServer struct {
 	// plugin scripts
 	pluginScripts map[string]string
 
+	// Serve index.html for routes of single-page apps that can't be found
+	spaFallback bool
+
+	// Modifies the main page after the runtime has been injected
+	mainPageHook func(html string) string
+
 	assetServerWebView
 }
 
@@ -55,7 +62,12 @@ func NewAssetServerMainPage(bindingsJSON string, options *options.App, servingFr
 	if err != nil {
 		return nil, err
 	}
-	return NewAssetServer(bindingsJSON, assetOptions, servingFromDisk, logger, runtime)
+	server, err := NewAssetServer(bindingsJSON, assetOptions, servingFromDisk, logger, runtime)
+	if err != nil {
+		return nil, err
+	}
+	server.UseMainPageHook(options.OnServeMainPage)
+	return server, nil
 }
 
 func NewAssetServer(bindingsJSON string, options assetserver.Options, servingFromDisk bool, logger Logger, runtime RuntimeAssets) (*AssetServer, error) {
@@ -64,7 +76,14 @@ func NewAssetServer(bindingsJSON string, options assetserver.Options, servingFro
 		return nil, err
 	}
 
-	return NewAssetServerWithHandler(handler, bindingsJSON, servingFromDisk, logger, runtime)
+	server, err := NewAssetServerWithHandler(handler, bindingsJSON, servingFromDisk, logger, runtime)
+	if err != nil {
+		return nil, err
+	}
+	server.RequestTimeout = options.RequestTimeout
+	server.dispatchWorkers = options.RequestWorkers
+	server.UseSPAFallback(options.SPAFallback)
+	return server, nil
 }
 
 func NewAssetServerWithHandler(handler http.Handler, bindingsJSON string, servingFromDisk bool, logger Logger, runtime RuntimeAssets) (*AssetServer, error) {
@@ -96,6 +115,17 @@ func (d *AssetServer) UseRuntimeHandler(handler RuntimeHandler) {
 	d.runtimeHandler = handler
 }
 
+// UseMainPageHook sets a hook which modifies the HTML of the main page before it's served, after the runtime has been
+// injected. A nil hook serves the page unmodified
+func (d *AssetServer) UseMainPageHook(hook func(html string) string) {
+	d.mainPageHook = hook
+}
+
+// UseSPAFallback sets whether routes of a single-page app which can't be found are served with the main page
+func (d *AssetServer) UseSPAFallback(enabled bool) {
+	d.spaFallback = enabled
+}
+
 func (d *AssetServer) AddPluginScript(pluginName string, script string) {
 	if d.pluginScripts == nil {
 		d.pluginScripts = make(map[string]string)
@@ -137,7 +167,7 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	} else if script, ok := d.pluginScripts[path]; ok {
 		d.writeBlob(rw, path, []byte(script))
-	} else if d.isRuntimeInjectionMatch(path) {
+	} else if d.isRuntimeInjectionMatch(path) || d.isSPAFallbackMatch(req) {
 		recorder := &bodyRecorder{
 			ResponseWriter: rw,
 			doRecord: func(code int, h http.Header) bool {
@@ -169,9 +199,20 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 				d.serveError(rw, err, "Unable to processIndexHTML")
 				return
 			}
+			if d.mainPageHook != nil {
+				content = []byte(d.mainPageHook(string(content)))
+			}
 			d.writeBlob(rw, indexHTML, content)
 
 		case http.StatusNotFound:
+			if path != "/" && d.isSPAFallbackMatch(req) {
+				d.logDebug("Route '%s' not found, serving '/' for SPA", path)
+				fallbackReq := req.Clone(req.Context())
+				fallbackReq.URL.Path = "/"
+				fallbackReq.URL.RawPath = ""
+				d.ServeHTTP(rw, fallbackReq)
+				return
+			}
 			d.writeBlob(rw, indexHTML, defaultHTML)
 
 		default:
@@ -245,6 +286,14 @@ func (d *AssetServer) logError(message string, args ...interface{}) {
 	}
 }
 
+// isSPAFallbackMatch returns true if the request might be a route of a single-page app, that should be served with
+// index.html if it can't be found
+func (d *AssetServer) isSPAFallbackMatch(req *http.Request) bool {
+	return d.spaFallback &&
+		path.Ext(req.URL.Path) == "" &&
+		strings.Contains(req.Header.Get(HeaderAccept), "text/html")
+}
+
 func (AssetServer) isRuntimeInjectionMatch(path string) bool {
 	if path == "" {
 		path = "/"
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
 )
@@ -15,6 +16,9 @@ type assetServerWebView struct {
 	// ExpectedWebViewHost is checked against the Request Host of every WebViewRequest, other hosts won't be processed.
 	ExpectedWebViewHost string
 
+	// RequestTimeout is the time a handler has to start the response of a WebViewRequest, 0 disables it.
+	RequestTimeout time.Duration
+
 	dispatchInit    sync.Once
 	dispatchReqC    chan<- webview.Request
 	dispatchWorkers int
@@ -23,6 +27,8 @@ type assetServerWebView struct {
 // ServeWebViewRequest processes the HTTP Request asynchronously by faking a golang HTTP Server.
 // The request will be finished with a StatusNotImplemented code if no handler has written to the response.
 // The AssetServer takes ownership of the request and the caller mustn't close it or access it in any other way.
+// Requests are served concurrently, either each in its own goroutine or by a fixed number of workers if RequestWorkers
+// has been set in the options.
 func (d *AssetServer) ServeWebViewRequest(req webview.Request) {
 	d.dispatchInit.Do(func() {
 		workers := d.dispatchWorkers
@@ -34,7 +40,7 @@ func (d *AssetServer) ServeWebViewRequest(req webview.Request) {
 		for i := 0; i < workers; i++ {
 			go func() {
 				for req := range workerC {
-					d.processWebViewRequest(req)
+					d.processWebViewRequest(req, d, false)
 				}
 			}()
 		}
@@ -46,15 +52,24 @@ func (d *AssetServer) ServeWebViewRequest(req webview.Request) {
 	})
 
 	if d.dispatchReqC == nil {
-		go d.processWebViewRequest(req)
+		go d.processWebViewRequest(req, d, false)
 	} else {
 		d.dispatchReqC <- req
 	}
 }
 
-func (d *AssetServer) processWebViewRequest(r webview.Request) {
+// ServeWebViewRequestWithHandler processes the HTTP Request of a custom scheme asynchronously with the given handler.
+// In contrast to ServeWebViewRequest the request URL keeps its scheme and host, the host isn't validated and the
+// runtime isn't injected. The handler takes ownership of the request and the caller mustn't close it or access it
+// in any other way.
+func ServeWebViewRequestWithHandler(req webview.Request, handler http.Handler, logger Logger) {
+	d := &AssetServer{logger: logger}
+	go d.processWebViewRequest(req, handler, true)
+}
+
+func (d *AssetServer) processWebViewRequest(r webview.Request, handler http.Handler, customScheme bool) {
 	uri, _ := r.URL()
-	d.processWebViewRequestInternal(r)
+	d.processWebViewRequestInternal(r, handler, customScheme)
 	if err := r.Close(); err != nil {
 		d.logError("Unable to call close for request for uri '%s'", uri)
 	}
@@ -62,7 +77,7 @@ func (d *AssetServer) processWebViewRequest(r webview.Request) {
 
 // processWebViewRequestInternal processes the HTTP Request by faking a golang HTTP Server.
 // The request will be finished with a StatusNotImplemented code if no handler has written to the response.
-func (d *AssetServer) processWebViewRequestInternal(r webview.Request) {
+func (d *AssetServer) processWebViewRequestInternal(r webview.Request, handler http.Handler, customScheme bool) {
 	uri := "unknown"
 	var err error
 
@@ -114,8 +129,11 @@ func (d *AssetServer) processWebViewRequestInternal(r webview.Request) {
 
 	// For server requests, the URL is parsed from the URI supplied on the Request-Line as stored in RequestURI. For
 	// most requests, fields other than Path and RawQuery will be empty. (See RFC 7230, Section 5.3)
-	req.URL.Scheme = ""
-	req.URL.Host = ""
+	// Custom schemes keep the scheme and host, the handler might need them to locate the content.
+	if !customScheme {
+		req.URL.Scheme = ""
+		req.URL.Host = ""
+	}
 	req.URL.Fragment = ""
 	req.URL.RawFragment = ""
 
@@ -141,12 +159,17 @@ func (d *AssetServer) processWebViewRequestInternal(r webview.Request) {
 		req.Host = host
 	}
 
-	if expectedHost := d.ExpectedWebViewHost; expectedHost != "" && expectedHost != req.Host {
+	if expectedHost := d.ExpectedWebViewHost; !customScheme && expectedHost != "" && expectedHost != req.Host {
 		d.webviewRequestErrorHandler(uri, rw, fmt.Errorf("expected host '%s' in request, but was '%s'", expectedHost, req.Host))
 		return
 	}
 
-	d.ServeHTTP(rw, req)
+	if timeout := d.RequestTimeout; timeout > 0 {
+		d.serveHTTPWithTimeout(rw, req, handler, timeout)
+		return
+	}
+
+	handler.ServeHTTP(rw, req)
 }
 
 func (d *AssetServer) webviewRequestErrorHandler(uri string, rw http.ResponseWriter, err error) {
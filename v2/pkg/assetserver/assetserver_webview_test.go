@@ -0,0 +1,291 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/assetserver/testdata"
+	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+type testLogger struct{}
+
+func (testLogger) Debug(message string, args ...interface{}) {}
+func (testLogger) Error(message string, args ...interface{}) {}
+
+type testRuntimeAssets struct{}
+
+func (testRuntimeAssets) DesktopIPC() []byte       { return []byte("// ipc") }
+func (testRuntimeAssets) WebsocketIPC() []byte     { return []byte("// websocket ipc") }
+func (testRuntimeAssets) RuntimeDesktopJS() []byte { return []byte("// runtime") }
+
+func newTestAssetServer(t *testing.T) *AssetServer {
+	t.Helper()
+	server, err := NewAssetServer("", assetserver.Options{Assets: testdata.TopLevelFS}, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+	return server
+}
+
+// serveWebViewRequest serves the request through the webview pipeline and waits until it has been closed
+func serveWebViewRequest(t *testing.T, server *AssetServer, req *webview.MemoryRequest) *webview.MemoryResponseWriter {
+	t.Helper()
+	server.ServeWebViewRequest(req)
+	select {
+	case <-req.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("request has not been closed")
+	}
+	response := req.Recorder()
+	require.True(t, response.Finished(), "response has not been finished")
+	return response
+}
+
+func TestServeWebViewRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		url             string
+		header          http.Header
+		wantCode        int
+		wantContentType string
+		wantBody        []string
+	}{
+		{
+			name:            "index is injected with the runtime",
+			method:          http.MethodGet,
+			url:             "http://wails.localhost/",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        []string{`<script src="/wails/ipc.js"></script>`, `<script src="/wails/runtime.js"></script>`, `<link href="/main.css" rel="stylesheet"/>`},
+		},
+		{
+			name:            "asset",
+			method:          http.MethodGet,
+			url:             "http://wails.localhost/main.css",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/css; charset=utf-8",
+		},
+		{
+			name:     "runtime",
+			method:   http.MethodGet,
+			url:      "http://wails.localhost/wails/runtime.js",
+			wantCode: http.StatusOK,
+			wantBody: []string{"// runtime"},
+		},
+		{
+			name:     "missing asset",
+			method:   http.MethodGet,
+			url:      "http://wails.localhost/missing.js",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unexpected host",
+			method:   http.MethodGet,
+			url:      "http://example.com/",
+			header:   http.Header{HeaderHost: []string{"example.com"}},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{HeaderHost: []string{"wails.localhost"}}
+			}
+			req := webview.NewMemoryRequest(tt.method, tt.url, header, nil)
+			response := serveWebViewRequest(t, newTestAssetServer(t), req)
+
+			require.Equal(t, tt.wantCode, response.Code())
+			if tt.wantContentType != "" {
+				require.Equal(t, tt.wantContentType, response.Header().Get(HeaderContentType))
+			}
+			body := string(response.Body())
+			for _, want := range tt.wantBody {
+				require.True(t, strings.Contains(body, want), "body %q does not contain %q", body, want)
+			}
+		})
+	}
+}
+
+func TestServeWebViewRequestWithHandler(t *testing.T) {
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set(HeaderContentType, "text/plain")
+		_, _ = rw.Write([]byte(req.URL.Scheme + " " + req.URL.Host + " " + req.URL.Path))
+	})
+
+	req := webview.NewMemoryRequest(http.MethodGet, "myassets://plugin/file.txt", http.Header{}, nil)
+	ServeWebViewRequestWithHandler(req, handler, testLogger{})
+	select {
+	case <-req.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("request has not been closed")
+	}
+
+	response := req.Recorder()
+	require.True(t, response.Finished(), "response has not been finished")
+	require.Equal(t, http.StatusOK, response.Code())
+	require.Equal(t, "text/plain", response.Header().Get(HeaderContentType))
+	require.Equal(t, "myassets plugin /file.txt", string(response.Body()))
+}
+
+func TestServeWebViewRequestTimeout(t *testing.T) {
+	cancelled := make(chan struct{})
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/slow":
+			<-req.Context().Done()
+			close(cancelled)
+		case "/streaming":
+			rw.WriteHeader(http.StatusOK)
+			time.Sleep(100 * time.Millisecond)
+			_, _ = rw.Write([]byte("streamed"))
+		default:
+			_, _ = rw.Write([]byte("fast"))
+		}
+	})
+
+	server, err := NewAssetServerWithHandler(handler, "", false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+	server.RequestTimeout = 50 * time.Millisecond
+
+	header := http.Header{HeaderHost: []string{"wails.localhost"}}
+
+	response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/slow", header, nil))
+	require.Equal(t, http.StatusGatewayTimeout, response.Code())
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request context has not been cancelled")
+	}
+
+	response = serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/streaming", header, nil))
+	require.Equal(t, http.StatusOK, response.Code())
+	require.Equal(t, "streamed", string(response.Body()))
+
+	response = serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/fast.txt", header, nil))
+	require.Equal(t, http.StatusOK, response.Code())
+	require.Equal(t, "fast", string(response.Body()))
+}
+
+func TestServeWebViewRequestWorkers(t *testing.T) {
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/slow" {
+			<-release
+		}
+		_, _ = rw.Write([]byte(req.URL.Path))
+	})
+
+	server, err := NewAssetServer("", assetserver.Options{Handler: handler, RequestWorkers: 2}, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+
+	header := http.Header{HeaderHost: []string{"wails.localhost"}}
+	slow := webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/slow", header, nil)
+	server.ServeWebViewRequest(slow)
+
+	// The slow request occupies one worker, the others are served by the second one
+	for i := 0; i < 10; i++ {
+		response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/fast", header, nil))
+		require.Equal(t, "/fast", string(response.Body()))
+	}
+
+	select {
+	case <-slow.Done():
+		t.Fatal("slow request has been closed before it was released")
+	default:
+	}
+	close(release)
+	select {
+	case <-slow.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow request has not been closed")
+	}
+	require.Equal(t, "/slow", string(slow.Recorder().Body()))
+}
+
+func TestServeWebViewRequestSPAFallback(t *testing.T) {
+	options := assetserver.Options{
+		Assets:      testdata.TopLevelFS,
+		SPAFallback: true,
+	}
+	server, err := NewAssetServer("", options, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+
+	tests := []struct {
+		name     string
+		url      string
+		accept   string
+		wantCode int
+		wantBody string
+	}{
+		{name: "route", url: "/users/1", accept: "text/html,application/xhtml+xml", wantCode: http.StatusOK, wantBody: `<script src="/wails/runtime.js"></script>`},
+		{name: "route with trailing slash", url: "/users/", accept: "text/html", wantCode: http.StatusOK, wantBody: `<script src="/wails/runtime.js"></script>`},
+		{name: "asset", url: "/main.css", accept: "text/html", wantCode: http.StatusOK},
+		{name: "missing asset", url: "/missing.js", accept: "text/html", wantCode: http.StatusNotFound},
+		{name: "not accepting html", url: "/api/users", accept: "application/json", wantCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{HeaderHost: []string{"wails.localhost"}, HeaderAccept: []string{tt.accept}}
+			req := webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost"+tt.url, header, nil)
+			response := serveWebViewRequest(t, server, req)
+
+			require.Equal(t, tt.wantCode, response.Code())
+			require.Contains(t, string(response.Body()), tt.wantBody)
+		})
+	}
+}
+
+func TestServeHTTPUseSPAFallback(t *testing.T) {
+	handler, err := NewAssetHandler(assetserver.Options{Assets: testdata.TopLevelFS}, testLogger{})
+	require.NoError(t, err)
+	server, err := NewAssetServerWithHandler(handler, "", false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+		req.Header.Set(HeaderAccept, "text/html")
+		rw := httptest.NewRecorder()
+		server.ServeHTTP(rw, req)
+		return rw
+	}
+
+	require.NotContains(t, serve().Body.String(), `<script src="/wails/runtime.js"></script>`)
+
+	server.UseSPAFallback(true)
+	rw := serve()
+	require.Equal(t, http.StatusOK, rw.Code)
+	require.Contains(t, rw.Body.String(), `<script src="/wails/runtime.js"></script>`)
+}
+
+func TestServeWebViewRequestMainPageHook(t *testing.T) {
+	appOptions := &options.App{
+		AssetServer: &assetserver.Options{Assets: testdata.TopLevelFS},
+		OnServeMainPage: func(html string) string {
+			return strings.Replace(html, "<head>", `<head><meta name="nonce" content="abc"/>`, 1)
+		},
+	}
+	server, err := NewAssetServerMainPage("", appOptions, false, testLogger{}, testRuntimeAssets{})
+	require.NoError(t, err)
+	server.ExpectedWebViewHost = "wails.localhost"
+
+	header := http.Header{HeaderHost: []string{"wails.localhost"}}
+	response := serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/", header, nil))
+	require.Equal(t, http.StatusOK, response.Code())
+	body := string(response.Body())
+	require.Contains(t, body, `<meta name="nonce" content="abc"/>`)
+	require.Contains(t, body, `<script src="/wails/runtime.js"></script>`)
+
+	response = serveWebViewRequest(t, server, webview.NewMemoryRequest(http.MethodGet, "http://wails.localhost/main.css", header, nil))
+	require.NotContains(t, string(response.Body()), "nonce")
+}
@@ -38,6 +38,13 @@ const (
 	HeaderUserAgent     = "User-Agent"
 	HeaderCacheControl  = "Cache-Control"
 	HeaderUpgrade       = "Upgrade"
+	HeaderRange         = "Range"
+	HeaderIfRange       = "If-Range"
+	HeaderAcceptRanges  = "Accept-Ranges"
+	HeaderContentRange  = "Content-Range"
+	HeaderAccept        = "Accept"
+
+	HeaderServiceWorkerAllowed = "Service-Worker-Allowed"
 
 	WailsUserAgentValue = "wails.io"
 )
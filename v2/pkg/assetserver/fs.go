@@ -73,3 +73,32 @@ func FindPathToFile(fsys fs.FS, file string) (string, error) {
 	}
 	return "", fmt.Errorf("%s: %w", file, os.ErrNotExist)
 }
+
+// LogAssets logs the path and size of every file in the fs.FS, followed by the number of files and their total size.
+// This helps to spot unexpectedly large or missing assets.
+func LogAssets(fsys fs.FS, log func(message string, args ...interface{})) error {
+	var count, total int64
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		log("Asset '%s' (%d bytes)", path, info.Size())
+		count++
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log("%d assets (%d bytes)", count, total)
+	return nil
+}
@@ -33,6 +33,7 @@ func TestGetMimetype(t *testing.T) {
 		{"html-utf8", args{"test_utf8.html", html}, "text/html; charset=utf-8"},
 		{"html-bom-utf8", args{"test_bom_utf8.html", bomHtml}, "text/html; charset=utf-8"},
 		{"svg", args{"test.svg", svg}, "image/svg+xml"},
+		{"wasm", args{"test.wasm", []byte{0x00, 0x61, 0x73, 0x6d}}, "application/wasm"},
 		{"svg-w-comment", args{"test_comment.svg", svgWithComment}, "image/svg+xml"},
 		{"svg-w-control-comment", args{"test_control_comment.svg", svgWithCommentAndControlChars}, "image/svg+xml"},
 		{"svg-w-bom-control-comment", args{"test_bom_control_comment.svg", svgWithBomCommentAndControlChars}, "image/svg+xml"},
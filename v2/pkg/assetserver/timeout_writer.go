@@ -0,0 +1,96 @@
+package assetserver
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// timeoutWriter guards the ResponseWriter of a request served with a timeout. The handler gets its own
+// header map, which is copied to the ResponseWriter when the response is started, so a timeout response
+// can be written without racing with the handler.
+type timeoutWriter struct {
+	rw     http.ResponseWriter
+	header http.Header
+
+	lock        sync.Mutex
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (w *timeoutWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *timeoutWriter) Write(buf []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if !w.wroteHeader {
+		w.writeHeader(http.StatusOK)
+	}
+	return w.rw.Write(buf)
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.timedOut || w.wroteHeader {
+		return
+	}
+	w.writeHeader(code)
+}
+
+// writeHeader must be called with the lock held
+func (w *timeoutWriter) writeHeader(code int) {
+	w.wroteHeader = true
+	header := w.rw.Header()
+	for key, values := range w.header {
+		header[key] = values
+	}
+	w.rw.WriteHeader(code)
+}
+
+// timeout answers the request with StatusGatewayTimeout, unless the handler has already started the
+// response. Returns true if the request has timed out
+func (w *timeoutWriter) timeout() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.wroteHeader {
+		return false
+	}
+	w.timedOut = true
+	http.Error(w.rw, http.StatusText(http.StatusGatewayTimeout), http.StatusGatewayTimeout)
+	return true
+}
+
+// serveHTTPWithTimeout serves the request and cancels its context if the handler hasn't started the
+// response within the timeout. A handler that has started the response is allowed to finish it.
+func (d *AssetServer) serveHTTPWithTimeout(rw http.ResponseWriter, req *http.Request, handler http.Handler, timeout time.Duration) {
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	tw := &timeoutWriter{rw: rw, header: http.Header{}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(tw, req.WithContext(ctx))
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return
+	case <-timer.C:
+	}
+
+	if tw.timeout() {
+		d.logError("Request '%s' has not been answered within %s (HttpResponse=504)", req.URL, timeout)
+		return
+	}
+	<-done
+}
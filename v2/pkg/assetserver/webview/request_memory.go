@@ -0,0 +1,142 @@
+package webview
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+var _ Request = &MemoryRequest{}
+
+// MemoryRequest is an in-memory Request which records the response. It can be used to exercise the
+// asset serving pipeline on any platform without a real WebView, EG: in tests.
+type MemoryRequest struct {
+	url    string
+	method string
+	header http.Header
+	body   []byte
+
+	response *MemoryResponseWriter
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewMemoryRequest creates a new MemoryRequest. A nil header is treated as an empty header.
+func NewMemoryRequest(method string, url string, header http.Header, body []byte) *MemoryRequest {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &MemoryRequest{
+		url:      url,
+		method:   method,
+		header:   header,
+		body:     body,
+		response: &MemoryResponseWriter{header: http.Header{}},
+		done:     make(chan struct{}),
+	}
+}
+
+func (r *MemoryRequest) URL() (string, error) {
+	return r.url, nil
+}
+
+func (r *MemoryRequest) Method() (string, error) {
+	return r.method, nil
+}
+
+func (r *MemoryRequest) Header() (http.Header, error) {
+	return r.header.Clone(), nil
+}
+
+func (r *MemoryRequest) Body() (io.ReadCloser, error) {
+	if r.body == nil {
+		return nil, nil
+	}
+	return io.NopCloser(bytes.NewReader(r.body)), nil
+}
+
+func (r *MemoryRequest) Response() ResponseWriter {
+	return r.response
+}
+
+// Recorder returns the recorded response
+func (r *MemoryRequest) Recorder() *MemoryResponseWriter {
+	return r.response
+}
+
+func (r *MemoryRequest) Close() error {
+	r.closeOnce.Do(func() { close(r.done) })
+	return nil
+}
+
+// Done returns a channel that is closed when the request has been closed by its owner
+func (r *MemoryRequest) Done() <-chan struct{} {
+	return r.done
+}
+
+var _ ResponseWriter = &MemoryResponseWriter{}
+
+// MemoryResponseWriter records the response of a MemoryRequest
+type MemoryResponseWriter struct {
+	lock     sync.Mutex
+	header   http.Header
+	code     int
+	body     bytes.Buffer
+	finished bool
+}
+
+func (rw *MemoryResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *MemoryResponseWriter) Write(buf []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	if rw.finished {
+		return 0, errResponseFinished
+	}
+	return rw.body.Write(buf)
+}
+
+func (rw *MemoryResponseWriter) WriteHeader(code int) {
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	if rw.code != 0 || rw.finished {
+		return
+	}
+	rw.code = code
+}
+
+func (rw *MemoryResponseWriter) Finish() error {
+	rw.WriteHeader(http.StatusNotImplemented)
+
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	rw.finished = true
+	return nil
+}
+
+// Code returns the status code of the response, 0 if no status has been written
+func (rw *MemoryResponseWriter) Code() int {
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	return rw.code
+}
+
+// Body returns the body of the response
+func (rw *MemoryResponseWriter) Body() []byte {
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	return bytes.Clone(rw.body.Bytes())
+}
+
+// Finished returns whether the response has been finished
+func (rw *MemoryResponseWriter) Finished() bool {
+	rw.lock.Lock()
+	defer rw.lock.Unlock()
+	return rw.finished
+}
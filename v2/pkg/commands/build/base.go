@@ -9,8 +9,10 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pterm/pterm"
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
 	"github.com/wailsapp/wails/v2/internal/conv"
 
 	"github.com/wailsapp/wails/v2/internal/system"
@@ -106,6 +108,25 @@ func (b *BaseBuilder) CleanUp() {
 	})
 }
 
+// buildInfoLDFlags returns the `-X` flags that set the application version details reported by runtime.AppVersion.
+// These are added before the user's ldflags so they can be overridden
+func buildInfoLDFlags(options *Options) []string {
+	var result []string
+	if options.ProjectData == nil {
+		return result
+	}
+	if version := options.ProjectData.Info.ProductVersion; version != "" {
+		result = append(result, fmt.Sprintf("-X '%s.Version=%s'", buildinfo.ImportPath, version))
+	}
+	if commit, _, err := shell.RunCommand(options.ProjectData.Path, "git", "rev-parse", "--short", "HEAD"); err == nil {
+		if commit = strings.TrimSpace(commit); commit != "" {
+			result = append(result, fmt.Sprintf("-X '%s.Commit=%s'", buildinfo.ImportPath, commit))
+		}
+	}
+	result = append(result, fmt.Sprintf("-X '%s.Date=%s'", buildinfo.ImportPath, time.Now().UTC().Format(time.RFC3339)))
+	return result
+}
+
 func commandPrettifier(args []string) string {
 	// If we have a single argument, just return it
 	if len(args) == 1 {
@@ -248,6 +269,7 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	// LDFlags
 	ldflags := slicer.String()
+	ldflags.AddSlice(buildInfoLDFlags(options))
 	if options.LDFlags != "" {
 		ldflags.Add(options.LDFlags)
 	}
@@ -595,7 +617,8 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 		pterm.Println("")
 		pterm.Info.Println("Build command: '" + buildCommand + "'")
 	}
-	stdout, stderr, err := shell.RunCommand(frontendDir, cmd[0], cmd[1:]...)
+	env := shell.SetEnv(os.Environ(), FrontendBuildTagsEnvironmentVariable, strings.Join(b.options.FrontendBuildTags(), ","))
+	stdout, stderr, err := shell.RunCommandWithEnv(env, frontendDir, cmd[0], cmd[1:]...)
 	if verbose || err != nil {
 		for _, l := range strings.Split(stdout, "\n") {
 			pterm.Printf("    %s\n", l)
@@ -1,6 +1,16 @@
 package build
 
-import "testing"
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/clilogger"
+)
 
 func Test_commandPrettifier(t *testing.T) {
 	tests := []struct {
@@ -32,3 +42,57 @@ func Test_commandPrettifier(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildFrontendTags(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the frontend build command uses sh")
+	}
+
+	dir := t.TempDir()
+	frontendDir := filepath.Join(dir, "frontend")
+	buildScript := `mkdir -p dist
+case ",$WAILS_BUILD_TAGS," in
+  *,pro,*) cp pro.txt dist/ ;;
+esac
+`
+	if err := os.MkdirAll(frontendDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(frontendDir, "build.sh"), []byte(buildScript), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(frontendDir, "pro.txt"), []byte("pro"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		options *Options
+		want    bool
+	}{
+		{"no tags", &Options{}, false},
+		{"user tags", &Options{UserTags: []string{"dev", "pro"}}, true},
+		{"frontend tags", &Options{UserTags: []string{"dev"}, FrontendTags: []string{"pro"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.RemoveAll(filepath.Join(frontendDir, "dist")); err != nil {
+				t.Fatal(err)
+			}
+			tt.options.IgnoreApplication = true
+			builder := NewBaseBuilder(tt.options)
+			builder.SetProjectData(&project.Project{
+				Path:         dir,
+				FrontendDir:  "frontend",
+				BuildCommand: "sh build.sh",
+			})
+			if err := builder.BuildFrontend(clilogger.New(io.Discard)); err != nil {
+				t.Fatal(err)
+			}
+			got := fs.FileExists(filepath.Join(frontendDir, "dist", "pro.txt"))
+			if got != tt.want {
+				t.Errorf("pro.txt included = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ const (
 type Options struct {
 	LDFlags           string               // Optional flags to pass to linker
 	UserTags          []string             // Tags to pass to the Go compiler
+	FrontendTags      []string             // Additional tags only passed to the frontend build, see FrontendBuildTags
 	Logger            *clilogger.CLILogger // All output to the logger
 	OutputType        string               // EG: desktop, server....
 	Mode              Mode                 // release or dev
@@ -72,6 +73,14 @@ type Options struct {
 	SkipEmbedCreate   bool                 // Skip creation of embed files
 }
 
+// FrontendBuildTagsEnvironmentVariable contains the FrontendBuildTags, comma separated, for the frontend build command
+const FrontendBuildTagsEnvironmentVariable = "WAILS_BUILD_TAGS"
+
+// FrontendBuildTags returns the tags of the frontend build: the user tags followed by the frontend tags
+func (o *Options) FrontendBuildTags() []string {
+	return lo.Uniq(append(append([]string{}, o.UserTags...), o.FrontendTags...))
+}
+
 // Build the project!
 func Build(options *Options) (string, error) {
 	// Extract logger
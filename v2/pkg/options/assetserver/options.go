@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"time"
 )
 
 // Options defines the configuration of the AssetServer.
@@ -33,6 +34,37 @@ type Options struct {
 	// Multiple Middlewares can be chained together with:
 	//   ChainMiddleware(middleware ...Middleware) Middleware
 	Middleware Middleware
+
+	// RequestTimeout is the maximum time a handler may take to start responding to a request of the webview. When it
+	// elapses, the context of the request is cancelled and the request is answered with `http.StatusGatewayTimeout`.
+	// Handlers that have started the response, e.g. streaming a large file, are not interrupted.
+	//
+	// If not defined, requests don't time out.
+	RequestTimeout time.Duration
+
+	// RequestWorkers is the number of requests of the webview that are served concurrently. Further requests are queued
+	// until a worker is available.
+	//
+	// If not defined, every request is served concurrently in its own goroutine.
+	RequestWorkers int
+
+	// MimeTypes maps file extensions, e.g. ".glb", to the Content-Type the files from Assets are served with. These take
+	// precedence over the detected Content-Types.
+	//
+	// If not defined, the Content-Type is detected by the file extension or by sniffing the content.
+	MimeTypes map[string]string
+
+	// ServiceWorker is the path of the service worker script in Assets, e.g. "/sw.js". It is served with the
+	// `Service-Worker-Allowed: /` header, so it can be registered for the root scope, and is never cached so updates
+	// of the service worker are picked up.
+	//
+	// If not defined, no service worker headers are added.
+	ServiceWorker string
+
+	// SPAFallback serves `index.html` for GET requests that accept HTML and have no file extension, if they can't be
+	// served from Assets or Handler. This allows the router of a single-page app to handle deep links, e.g. "/users/1".
+	// Requests for paths with a file extension, e.g. "/logo.png", are never rewritten.
+	SPAFallback bool
 }
 
 // Validate the options
@@ -1,7 +1,12 @@
 package options
 
+import "time"
+
 // Debug options which are taken into account in debug builds.
 type Debug struct {
 	// OpenInspectorOnStartup opens the inspector on startup of the app.
 	OpenInspectorOnStartup bool
+	// MainLoopWatchdogThreshold is how long the main loop may be unresponsive before a warning is logged, EG: because
+	// of heavy work on the main thread. 0 uses 500ms and a negative value disables the watchdog.
+	MainLoopWatchdogThreshold time.Duration
 }
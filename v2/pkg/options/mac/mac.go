@@ -14,6 +14,16 @@ type AboutInfo struct {
 	Icon    []byte
 }
 
+// RequestRule adds headers to, or rewrites the host of, requests whose URL starts with URLPrefix
+type RequestRule struct {
+	// URLPrefix is matched against the start of the absolute request URL, EG: "https://api.example.com/"
+	URLPrefix string `json:"urlPrefix"`
+	// Headers are set on every matching request
+	Headers map[string]string `json:"headers"`
+	// RewriteHost replaces the host (and port) of matching requests if set, EG: "staging.example.com"
+	RewriteHost string `json:"rewriteHost"`
+}
+
 // Options are options specific to Mac
 type Options struct {
 	TitleBar             *TitleBar
@@ -23,6 +33,12 @@ type Options struct {
 	WindowIsTranslucent  bool
 	Preferences          *Preferences
 	DisableZoom          bool
+	// TrimMemoryOnMemoryPressure clears the webview caches when the system reports memory pressure
+	TrimMemoryOnMemoryPressure bool
+	// ClipboardChangeEvents emits "wails:clipboard:changed" with the new text when the clipboard changes
+	ClipboardChangeEvents bool
+	// RequestRules modify the fetch and XMLHttpRequest requests made by the frontend. Default: no interception
+	RequestRules []RequestRule
 	// ActivationPolicy     ActivationPolicy
 	About      *AboutInfo
 	OnFileOpen func(filePath string) `json:"-"`
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 	"github.com/wailsapp/wails/v2/pkg/options/linux"
@@ -99,11 +100,57 @@ type App struct {
 	// DragAndDrop options for drag and drop behavior
 	DragAndDrop *DragAndDrop
 
+	// SplashScreen shows a native splash window until the frontend is ready. Currently only supported on macOS.
+	SplashScreen *SplashScreen
+
+	// StartupWatchdog reports the likely causes if the frontend doesn't become ready in time, EG: a blank window
+	// because of a broken bundle. Default: disabled
+	StartupWatchdog *StartupWatchdog
+
 	// DisablePanicRecovery disables the panic recovery system in messages processing
 	DisablePanicRecovery bool
 
 	// List of additional allowed origins for bindings in format "https://*.myapp.com,https://example.com"
 	BindingsAllowedOrigins string
+
+	// OnDownload is called when the webview content starts a download, EG: a response with a
+	// "Content-Disposition: attachment" header. It returns the path to save the file to, or false to
+	// decline the download. If nil, downloads are saved to the Downloads folder with the suggested name.
+	// Currently only supported on macOS 11.3+
+	OnDownload func(url string, suggestedName string) (savePath string, accept bool) `json:"-"`
+
+	// UserScripts are JavaScript files that are injected into every page, EG: polyfills or instrumentation.
+	// The files are read on startup. Currently only supported on macOS
+	UserScripts []UserScript
+
+	// CustomSchemes registers additional URL schemes, e.g. "myassets://", whose requests are served by Go handlers.
+	// Currently only supported on macOS.
+	CustomSchemes []CustomScheme
+
+	// WindowStatePath is the path of a file the position, size and state of the window are saved to on quit. On the
+	// next start the window is restored from it, including a maximised or fullscreen state, which overrides
+	// WindowStartState. Currently only supported on macOS.
+	WindowStatePath string
+
+	// StartScreen is the number of the screen the window is centered on at launch, starting with 1 in the order of
+	// runtime.ScreenGetAll. 0, or a screen which doesn't exist, opens the window on the primary screen. A window state
+	// restored from WindowStatePath takes precedence. Currently only supported on macOS.
+	StartScreen int
+
+	// OnServeMainPage is called with the HTML of the main page, after the runtime has been injected, every time it's
+	// served. It returns the HTML to serve, EG: with additional meta tags or a per-load CSP nonce.
+	OnServeMainPage func(html string) string `json:"-"`
+
+	// LaunchArgsFilter is called with the command line arguments of the app, without the program name. It returns the
+	// arguments exposed by runtime.LaunchArgs, EG: without tokens or passwords. If nil, all arguments are exposed.
+	LaunchArgsFilter func(args []string) []string `json:"-"`
+
+	// OnCrash is called with the report of a panic in the startup or the event processing of the application,
+	// before the application exits. The report is logged regardless.
+	OnCrash func(report CrashReport) `json:"-"`
+
+	// EventEncoding is the encoding of the events sent from Go to the frontend. Default EventEncodingJSON
+	EventEncoding EventEncoding
 }
 
 type ErrorFormatter func(error) any
@@ -177,6 +224,22 @@ func MergeDefaults(appoptions *App) {
 		}
 	}
 
+	if appoptions.SplashScreen != nil {
+		if appoptions.SplashScreen.Width <= 0 {
+			appoptions.SplashScreen.Width = 400
+		}
+		if appoptions.SplashScreen.Height <= 0 {
+			appoptions.SplashScreen.Height = 300
+		}
+		if appoptions.SplashScreen.Timeout <= 0 {
+			appoptions.SplashScreen.Timeout = 10 * time.Second
+		}
+	}
+
+	if appoptions.StartupWatchdog != nil && appoptions.StartupWatchdog.Timeout <= 0 {
+		appoptions.StartupWatchdog.Timeout = 10 * time.Second
+	}
+
 	// Ensure max and min are valid
 	processMinMaxConstraints(appoptions)
 
@@ -215,6 +278,79 @@ type DragAndDrop struct {
 	CSSDropValue string
 }
 
+type SplashScreen struct {
+	// Image is the image data (PNG, JPEG, ...) shown in the splash window
+	Image []byte
+
+	// HTML is a minimal HTML snippet shown in the splash window if no Image is given
+	HTML string
+
+	// Size of the splash window. Default 400x300
+	Width  int
+	Height int
+
+	// Timeout after which the splash is dismissed and the main window is shown, even if
+	// the frontend never became ready. Default 10 seconds
+	Timeout time.Duration
+}
+
+type StartupWatchdog struct {
+	// Timeout after which the diagnostic is reported if the frontend isn't ready. Default 10 seconds
+	Timeout time.Duration
+
+	// ShowDialog shows the diagnostic in a native dialog. It's always logged as a warning
+	ShowDialog bool
+}
+
+type UserScriptInjectionTime int
+
+const (
+	// UserScriptAtDocumentEnd injects the script after the document has loaded, before subresources like images
+	UserScriptAtDocumentEnd UserScriptInjectionTime = 0
+	// UserScriptAtDocumentStart injects the script before any other script of the page is run
+	UserScriptAtDocumentStart UserScriptInjectionTime = 1
+)
+
+// CrashReport describes a panic which terminates the application
+type CrashReport struct {
+	// Source is where the panic happened, EG: "startup" or "message processor"
+	Source string
+	// Panic is the value passed to panic
+	Panic interface{}
+	// Stack is the stack trace of the goroutine which panicked
+	Stack string
+	// Time is when the panic happened
+	Time time.Time
+}
+
+type EventEncoding int
+
+const (
+	// EventEncodingJSON sends events to the frontend as JSON
+	EventEncodingJSON EventEncoding = 0
+	// EventEncodingMsgpack sends events to the frontend as MessagePack, which is faster to encode and smaller
+	// for large or frequent events, EG: telemetry. Byte slices arrive as an Uint8Array instead of a base64 string
+	EventEncodingMsgpack EventEncoding = 1
+)
+
+type UserScript struct {
+	// Path of the JavaScript file
+	Path string
+
+	// InjectionTime is when the script is injected. Default UserScriptAtDocumentEnd
+	InjectionTime UserScriptInjectionTime
+}
+
+type CustomScheme struct {
+	// Scheme is the name of the scheme without "://", e.g. "myassets". The schemes handled natively by the
+	// webview, e.g. "http", "https" or "file", and the "wails" scheme can't be registered.
+	Scheme string
+
+	// Handler serves the requests on the scheme. The request URL contains the scheme and host as requested
+	// by the webview.
+	Handler http.Handler
+}
+
 func NewSecondInstanceData() (*SecondInstanceData, error) {
 	ex, err := os.Executable()
 	if err != nil {
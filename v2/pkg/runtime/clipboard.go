@@ -1,6 +1,13 @@
 package runtime
 
-import "context"
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// ErrClipboardEmpty is returned by ClipboardGetImage if the clipboard doesn't contain an image
+var ErrClipboardEmpty = frontend.ErrClipboardEmpty
 
 func ClipboardGetText(ctx context.Context) (string, error) {
 	appFrontend := getFrontend(ctx)
@@ -11,3 +18,15 @@ func ClipboardSetText(ctx context.Context, text string) error {
 	appFrontend := getFrontend(ctx)
 	return appFrontend.ClipboardSetText(text)
 }
+
+// ClipboardGetImage returns the image on the clipboard and its MIME type
+func ClipboardGetImage(ctx context.Context) ([]byte, string, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ClipboardGetImage()
+}
+
+// ClipboardSetImage writes the image with the given MIME type to the clipboard
+func ClipboardSetImage(ctx context.Context, data []byte, mime string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ClipboardSetImage(data, mime)
+}
@@ -3,8 +3,13 @@ package runtime
 import (
 	"context"
 	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
+// DragItem is an item dragged out of the application with StartDrag
+type DragItem = frontend.DragItem
+
 // OnFileDrop returns a slice of file path strings when a drop is finished.
 func OnFileDrop(ctx context.Context, callback func(x, y int, paths []string)) {
 	if callback == nil {
@@ -35,3 +40,11 @@ func OnFileDrop(ctx context.Context, callback func(x, y int, paths []string)) {
 func OnFileDropOff(ctx context.Context) {
 	EventsOff(ctx, "wails:file-drop")
 }
+
+// StartDrag starts dragging the given items out of the application, EG: to the Finder. It has to be
+// called while the mouse button is pressed, EG: from a bound method called in a "mousedown" handler.
+// Currently only supported on macOS
+func StartDrag(ctx context.Context, items []DragItem) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.StartDrag(items)
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"time"
 )
 
 // EventsOn registers a listener for the given event name. It returns a function to cancel the listener
@@ -42,8 +43,16 @@ func EventsOnMultiple(ctx context.Context, eventName string, callback func(optio
 	return events.OnMultiple(eventName, callback, counter)
 }
 
-// EventsEmit pass through
+// EventsEmit emits the event to the Go listeners registered with EventsOn and the listeners in the frontend.
+// Go listeners are called in their own goroutine
 func EventsEmit(ctx context.Context, eventName string, optionalData ...interface{}) {
 	events := getEvents(ctx)
 	events.Emit(eventName, optionalData...)
 }
+
+// EventsNotifyThrottled sends the event to the frontend at most once per minInterval for each event name, EG: for
+// progress updates. The latest dropped event is sent when the interval ends. Go listeners are not notified
+func EventsNotifyThrottled(ctx context.Context, eventName string, minInterval time.Duration, optionalData ...interface{}) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.NotifyThrottled(eventName, minInterval, optionalData...)
+}
@@ -0,0 +1,16 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type NetworkStatus = frontend.NetworkStatus
+
+// GetNetworkStatus returns the current network connectivity.
+// The "wails:network:online" and "wails:network:offline" events are emitted when it changes
+func GetNetworkStatus(ctx context.Context) (NetworkStatus, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetNetworkStatus()
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"log"
 	goruntime "runtime"
+	"time"
 
+	"github.com/wailsapp/wails/v2/internal/buildinfo"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
 )
@@ -69,6 +71,34 @@ func Quit(ctx context.Context) {
 	appFrontend.Quit()
 }
 
+// QuitWithCode quits the application like Quit and exits the process with the given code once it has shut down
+func QuitWithCode(ctx context.Context, code int) {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.QuitWithCode': %s", contextError)
+	}
+	appFrontend := getFrontend(ctx)
+	appFrontend.QuitWithCode(code)
+}
+
+// RelaunchApp starts a new instance of the application with the given args and quits this one, EG: to apply settings.
+// OnBeforeClose can prevent it like it prevents Quit
+func RelaunchApp(ctx context.Context, args []string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.RelaunchApp(args)
+}
+
+// SetLaunchAtLogin sets whether the application is launched when the user logs in
+func SetLaunchAtLogin(ctx context.Context, enabled bool) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.SetLaunchAtLogin(enabled)
+}
+
+// GetLaunchAtLogin returns true if the application is launched when the user logs in
+func GetLaunchAtLogin(ctx context.Context) (bool, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetLaunchAtLogin()
+}
+
 // Hide the application
 func Hide(ctx context.Context) {
 	if ctx == nil {
@@ -87,6 +117,28 @@ func Show(ctx context.Context) {
 	appFrontend.Show()
 }
 
+// RunOnMainThread runs fn on the main (UI) thread and waits for it to return, EG: for bound methods calling native
+// APIs which must run on the main thread. The methods of the runtime already do this internally. fn blocks the UI
+// while it runs and must not call runtime methods which wait for the main thread, EG: the dialogs. A panic in fn is
+// raised again in the calling goroutine
+func RunOnMainThread(ctx context.Context, fn func()) {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.RunOnMainThread': %s", contextError)
+	}
+	appFrontend := getFrontend(ctx)
+	appFrontend.RunOnMainThread(fn)
+}
+
+// TrimMemory clears the webview caches to reduce the memory usage of the application.
+// Currently only supported on macOS
+func TrimMemory(ctx context.Context) {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.TrimMemory': %s", contextError)
+	}
+	appFrontend := getFrontend(ctx)
+	appFrontend.TrimMemory()
+}
+
 // EnvironmentInfo contains information about the environment
 type EnvironmentInfo struct {
 	BuildType string `json:"buildType"`
@@ -94,6 +146,41 @@ type EnvironmentInfo struct {
 	Arch      string `json:"arch"`
 }
 
+// SetIdleTimeout calls the callback when there has been no mouse or keyboard input in the webview for
+// the given timeout, EG: to lock the application. The "wails:app:idle" event is emitted when the
+// application becomes idle and "wails:app:active" on the next input. A timeout of 0 disables it
+func SetIdleTimeout(ctx context.Context, timeout time.Duration, callback func()) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.SetIdleTimeout(timeout, callback)
+}
+
+// AppVersionInfo contains the version details of the application
+type AppVersionInfo struct {
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+	Date    string `json:"date"`
+}
+
+// AppVersion returns the version details of the application. These are set when building with Wails:
+// the version is the `productVersion` in wails.json, falling back to the bundle version on macOS
+func AppVersion() AppVersionInfo {
+	return AppVersionInfo{
+		Version: buildinfo.GetVersion(),
+		Commit:  buildinfo.Commit,
+		Date:    buildinfo.Date,
+	}
+}
+
+// LaunchArgs returns the command line arguments the application was launched with, without the program name.
+// These are filtered by options.App.LaunchArgsFilter
+func LaunchArgs(ctx context.Context) []string {
+	if ctx == nil {
+		log.Fatalf("Error calling 'runtime.LaunchArgs': %s", contextError)
+	}
+	args, _ := ctx.Value("launchargs").([]string)
+	return args
+}
+
 // Environment returns information about the environment
 func Environment(ctx context.Context) EnvironmentInfo {
 	var result EnvironmentInfo
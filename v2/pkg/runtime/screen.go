@@ -13,3 +13,10 @@ func ScreenGetAll(ctx context.Context) ([]Screen, error) {
 	appFrontend := getFrontend(ctx)
 	return appFrontend.ScreenGetAll()
 }
+
+// WindowGetCurrentScreen returns the screen which contains the center of the window, EG: to position dialogs on
+// the same screen as the window
+func WindowGetCurrentScreen(ctx context.Context) (*Screen, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowGetCurrentScreen()
+}
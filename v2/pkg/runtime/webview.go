@@ -0,0 +1,84 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// SetContentRules applies the given WebKit content-blocker rules (JSON) to the webview.
+// Currently only supported on macOS
+func SetContentRules(ctx context.Context, rules string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.SetContentRules(rules)
+}
+
+// ClearContentRules removes all content-blocker rules from the webview
+func ClearContentRules(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.ClearContentRules()
+}
+
+// FindOptions contains the options for FindInPage
+type FindOptions = frontend.FindOptions
+
+// FindInPage highlights the next match of the query in the page and returns the total number of matches.
+// Calling it again with the same query moves to the next match. The "wails:find:result" event is emitted
+// with the current match index and the total. Currently only supported on macOS 11+
+func FindInPage(ctx context.Context, query string, options FindOptions) (int, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.FindInPage(query, options)
+}
+
+// FindStopSession ends the current find session
+func FindStopSession(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.FindStopSession()
+}
+
+// GetSessionState returns the opaque interaction state of the webview, including the navigation history,
+// scroll positions and form data, EG: to reopen the app where the user left off. Currently only supported on macOS 12+
+func GetSessionState(ctx context.Context) ([]byte, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetSessionState()
+}
+
+// RestoreSessionState restores a state returned by GetSessionState
+func RestoreSessionState(ctx context.Context, state []byte) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.RestoreSessionState(state)
+}
+
+// HighlightElement draws an overlay around the first element matching the CSS selector, EG: for guided tours.
+// The highlight follows the element until ClearHighlight is called or another element is highlighted
+func HighlightElement(ctx context.Context, selector string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.HighlightElement(selector)
+}
+
+// ClearHighlight removes the highlight added by HighlightElement
+func ClearHighlight(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.ClearHighlight()
+}
+
+// InjectCSS adds the CSS to the page as a stylesheet with the given id, replacing any stylesheet injected
+// with the same id. Unlike ExecJS, the stylesheet is applied again after navigating or reloading
+func InjectCSS(ctx context.Context, id string, css string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.InjectCSS(id, css)
+}
+
+// RemoveCSS removes the stylesheet injected by InjectCSS with the given id
+func RemoveCSS(ctx context.Context, id string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.RemoveCSS(id)
+}
+
+// GetSelectedText returns the text currently selected in the webview. Whenever the selection
+// changes, the "wails:selection:changed" event is emitted with the selected text.
+// Currently only supported on macOS
+func GetSelectedText(ctx context.Context) (string, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetSelectedText()
+}
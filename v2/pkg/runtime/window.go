@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -12,6 +13,20 @@ func WindowSetTitle(ctx context.Context, title string) {
 	appFrontend.WindowSetTitle(title)
 }
 
+// WindowSetIcon sets the icon of the application in the dock to the PNG image, EG: to show an unread count.
+// Invalid images are logged and leave the icon unchanged. Currently only supported on macOS
+func WindowSetIcon(ctx context.Context, data []byte) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetIcon(data)
+}
+
+// DockSetBadge sets the badge label of the application in the dock, EG: a notification count. An empty label removes
+// the badge. Currently only supported on macOS
+func DockSetBadge(ctx context.Context, label string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.DockSetBadge(label)
+}
+
 // WindowFullscreen makes the window fullscreen
 func WindowFullscreen(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
@@ -92,12 +107,36 @@ func WindowSetMaxSize(ctx context.Context, width int, height int) {
 	appFrontend.WindowSetMaxSize(width, height)
 }
 
+// WindowSetMinSizeFraction sets the minimum size of the window as fractions of the current screen size
+func WindowSetMinSizeFraction(ctx context.Context, widthFraction float64, heightFraction float64) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMinSizeFraction(widthFraction, heightFraction)
+}
+
+// WindowSetMaxSizeFraction sets the maximum size of the window as fractions of the current screen size
+func WindowSetMaxSizeFraction(ctx context.Context, widthFraction float64, heightFraction float64) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMaxSizeFraction(widthFraction, heightFraction)
+}
+
 // WindowSetAlwaysOnTop sets the window AlwaysOnTop or not on top
 func WindowSetAlwaysOnTop(ctx context.Context, b bool) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowSetAlwaysOnTop(b)
 }
 
+// WindowSetMinimizable enables or disables minimising the window by the user
+func WindowSetMinimizable(ctx context.Context, b bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMinimizable(b)
+}
+
+// WindowSetMaximizable enables or disables maximising the window by the user
+func WindowSetMaximizable(ctx context.Context, b bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMaximizable(b)
+}
+
 // WindowSetPosition sets the position of the window
 func WindowSetPosition(ctx context.Context, x int, y int) {
 	appFrontend := getFrontend(ctx)
@@ -115,6 +154,21 @@ func WindowMaximise(ctx context.Context) {
 	appFrontend.WindowMaximise()
 }
 
+// WindowShowZoomMenu shows the menu of the zoom button of the window, EG: for a button of a custom title bar.
+// Currently only supported on macOS
+func WindowShowZoomMenu(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowShowZoomMenu()
+}
+
+// WindowRequestUserAttention asks for the attention of the user if the application isn't active. On macOS the dock
+// icon bounces, until the application is activated if critical and once otherwise. On Windows the taskbar button
+// flashes. The request is cancelled when the window gains focus
+func WindowRequestUserAttention(ctx context.Context, critical bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowRequestUserAttention(critical)
+}
+
 // WindowToggleMaximise the window
 func WindowToggleMaximise(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
@@ -169,6 +223,29 @@ func WindowExecJS(ctx context.Context, js string) {
 	appFrontend.ExecJS(js)
 }
 
+// ErrExecJSRecordingDisabled is returned by WindowExecJSRecordingStart in production builds
+var ErrExecJSRecordingDisabled = frontend.ErrExecJSRecordingDisabled
+
+// WindowExecJSRecordingStart records all JS sent to the window, including events and the results of bound
+// method calls, EG: to assert on them in tests. If passthrough is false the JS is only recorded and not executed.
+// Only supported in dev and debug builds
+func WindowExecJSRecordingStart(ctx context.Context, passthrough bool) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ExecJSRecordingStart(passthrough)
+}
+
+// WindowExecJSRecordingDrain returns the JS recorded since the last drain and clears the buffer
+func WindowExecJSRecordingDrain(ctx context.Context) []string {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ExecJSRecordingDrain()
+}
+
+// WindowExecJSRecordingStop stops recording and returns the JS which hasn't been drained
+func WindowExecJSRecordingStop(ctx context.Context) []string {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ExecJSRecordingStop()
+}
+
 func WindowSetBackgroundColour(ctx context.Context, R, G, B, A uint8) {
 	appFrontend := getFrontend(ctx)
 	col := &options.RGBA{
@@ -184,3 +261,134 @@ func WindowPrint(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowPrint()
 }
+
+// WindowOpenDevTools opens the devtools if they are enabled
+func WindowOpenDevTools(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowOpenDevTools()
+}
+
+// WindowCloseDevTools closes the devtools if they are enabled
+func WindowCloseDevTools(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowCloseDevTools()
+}
+
+// WebViewSetBackgroundColour sets the background colour of the webview, which is shown
+// before the frontend content has painted
+func WebViewSetBackgroundColour(ctx context.Context, R, G, B, A uint8) {
+	appFrontend := getFrontend(ctx)
+	col := &options.RGBA{
+		R: R,
+		G: G,
+		B: B,
+		A: A,
+	}
+	appFrontend.WebViewSetBackgroundColour(col)
+}
+
+// WindowSetSpellCheckEnabled enables or disables spellchecking in the webview. By default the system setting is used
+func WindowSetSpellCheckEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetSpellCheckEnabled(enabled)
+}
+
+// WindowSetEnabled enables or disables all user input to the window, EG: during a modal backend operation
+func WindowSetEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetEnabled(enabled)
+}
+
+// WindowSetJavaScriptEnabled enables or disables JavaScript in the webview for subsequent navigations,
+// EG: to display untrusted HTML. The Wails runtime and bindings don't work while JavaScript is disabled
+func WindowSetJavaScriptEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetJavaScriptEnabled(enabled)
+}
+
+// WindowSetShadow shows or hides the shadow of the window, EG: for frameless or transparent windows
+func WindowSetShadow(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetShadow(enabled)
+}
+
+// WindowSetCornerRadius rounds the corners of the window with the given radius in points, EG: for frameless windows.
+// A radius of 0 restores the default corners
+func WindowSetCornerRadius(ctx context.Context, radius float64) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCornerRadius(radius)
+}
+
+// WindowBack navigates the webview back in its history
+func WindowBack(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowBack()
+}
+
+// WindowForward navigates the webview forward in its history
+func WindowForward(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowForward()
+}
+
+// WindowCanGoBack returns true if the webview can navigate back in its history
+func WindowCanGoBack(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowCanGoBack()
+}
+
+// WindowCanGoForward returns true if the webview can navigate forward in its history
+func WindowCanGoForward(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowCanGoForward()
+}
+
+// WindowStopLoading stops loading the current page
+func WindowStopLoading(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowStopLoading()
+}
+
+// WindowSetSpellCheckLanguage sets the spellchecking language, EG: "en_GB". An empty string detects the language automatically
+func WindowSetSpellCheckLanguage(ctx context.Context, language string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetSpellCheckLanguage(language)
+}
+
+// WindowSetCollectionBehavior sets how the window behaves with Spaces, Stage Manager and fullscreen EG: "canJoinAllSpaces",
+// "stationary", "participatesInCycle". The flags replace the current behaviour. Only supported on macOS
+func WindowSetCollectionBehavior(ctx context.Context, flags []string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetCollectionBehavior(flags)
+}
+
+// WindowState is the state of a window
+type WindowState = frontend.WindowState
+
+const (
+	WindowStateNormal     = frontend.WindowStateNormal
+	WindowStateMaximised  = frontend.WindowStateMaximised
+	WindowStateMinimised  = frontend.WindowStateMinimised
+	WindowStateFullscreen = frontend.WindowStateFullscreen
+)
+
+// WindowInfo describes an application window
+type WindowInfo = frontend.WindowInfo
+
+// WindowGetTitle returns the title of the window
+func WindowGetTitle(ctx context.Context) string {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowGetTitle()
+}
+
+// WindowGetState returns the state of the window: normal, maximised, minimised or fullscreen
+func WindowGetState(ctx context.Context) WindowState {
+	appFrontend := getFrontend(ctx)
+	return frontend.WindowGetState(appFrontend)
+}
+
+// WindowList returns the application windows. Currently this is only the main window
+func WindowList(ctx context.Context) []WindowInfo {
+	appFrontend := getFrontend(ctx)
+	return frontend.WindowList(appFrontend)
+}